@@ -0,0 +1,163 @@
+// Package container locates the raw EXIF/TIFF payload embedded in common
+// image container formats (JPEG, PNG, WebP, HEIF/HEIC, and TIFF itself,
+// including its RAW derivatives such as DNG/CR2/NEF/ARW) without decoding
+// it into tags. It exists so that code needing only the raw bytes — a
+// maker-note parser, a "does this file even have EXIF" check — doesn't
+// need to pull in the full tiff/exif decode pipeline, and so that
+// container sniffing/extraction logic has exactly one home instead of
+// being re-derived per caller.
+package container
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Format identifies a container format recognized by Sniff.
+type Format int
+
+const (
+	FormatUnknown Format = iota
+	FormatJPEG
+	FormatPNG
+	FormatWebP
+	FormatHEIF
+	FormatTIFF
+)
+
+func (f Format) String() string {
+	switch f {
+	case FormatJPEG:
+		return "jpeg"
+	case FormatPNG:
+		return "png"
+	case FormatWebP:
+		return "webp"
+	case FormatHEIF:
+		return "heif"
+	case FormatTIFF:
+		return "tiff"
+	default:
+		return "unknown"
+	}
+}
+
+var pngSignature = []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n'}
+
+// Sniff inspects header, the first bytes of a file (16 bytes covers every
+// signature below; a shorter header just degrades to FormatUnknown rather
+// than panicking), and reports which Format it looks like.
+func Sniff(header []byte) Format {
+	switch {
+	case len(header) >= 2 && header[0] == 0xFF && header[1] == 0xD8:
+		return FormatJPEG
+	case len(header) >= 8 && bytes.Equal(header[:8], pngSignature):
+		return FormatPNG
+	case len(header) >= 12 && bytes.Equal(header[0:4], []byte("RIFF")) && bytes.Equal(header[8:12], []byte("WEBP")):
+		return FormatWebP
+	case len(header) >= 4 && (string(header[0:4]) == "II*\x00" || string(header[0:4]) == "MM\x00*"):
+		return FormatTIFF
+	case len(header) >= 12 && string(header[4:8]) == "ftyp":
+		return FormatHEIF
+	default:
+		return FormatUnknown
+	}
+}
+
+// ErrNoExif is returned by ExtractRawExif when the container format was
+// recognized but it carries no embedded EXIF/TIFF payload.
+var ErrNoExif = errors.New("container: no embedded EXIF data found")
+
+// ErrUnrecognized is returned by ExtractRawExif when neither hint nor
+// Sniff(header) identify a supported container format.
+var ErrUnrecognized = errors.New("container: unrecognized file format")
+
+// ExtractRawExif sniffs r's format (or uses hint directly, if it isn't
+// FormatUnknown, skipping the sniff) and returns the raw EXIF/TIFF bytes
+// it embeds, without any "Exif\x00\x00" APP1 prefix. TIFF-based raw
+// formats (DNG, CR2, NEF, ARW, ...) sniff as FormatTIFF and are returned
+// whole, since the file itself already is the TIFF/EXIF structure;
+// callers still need to traverse its SubIFDs (via tiff.Decode/exif.Exif
+// as usual) to reach the actual image IFD alongside the main one.
+func ExtractRawExif(r io.ReaderAt, hint Format) ([]byte, error) {
+	header := make([]byte, 16)
+	n, err := r.ReadAt(header, 0)
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("container: reading header: %w", err)
+	}
+	header = header[:n]
+
+	format := hint
+	if format == FormatUnknown {
+		format = Sniff(header)
+	}
+
+	switch format {
+	case FormatJPEG:
+		return extractJPEG(r)
+	case FormatPNG:
+		return extractPNG(r)
+	case FormatWebP:
+		return extractWebP(r)
+	case FormatHEIF:
+		return extractHEIF(r)
+	case FormatTIFF:
+		return extractTIFF(r)
+	default:
+		return nil, ErrUnrecognized
+	}
+}
+
+// ExtractRawEXIF is ExtractRawExif for callers holding an io.ReadSeeker
+// (e.g. an *os.File) rather than an io.ReaderAt, that also want to know
+// which Format was detected. It seeks r back to the start, reads it
+// fully (an io.ReadSeeker gives no cheaper way to get random access than
+// bytes.Reader does), and sniffs/extracts exactly as ExtractRawExif does.
+func ExtractRawEXIF(r io.ReadSeeker) ([]byte, Format, error) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, FormatUnknown, fmt.Errorf("container: seeking to start: %w", err)
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, FormatUnknown, fmt.Errorf("container: reading input: %w", err)
+	}
+
+	br := bytes.NewReader(data)
+	format := Sniff(data)
+	raw, err := ExtractRawExif(br, format)
+	return raw, format, err
+}
+
+// readAllAt reads every byte r has to offer starting at 0, growing its
+// buffer until ReadAt reports io.EOF. It's used where the whole input is
+// the payload (FormatTIFF) and no ReaderAt.Size method is available to
+// preallocate against.
+func readAllAt(r io.ReaderAt) ([]byte, error) {
+	var buf []byte
+	chunk := make([]byte, 32*1024)
+	var pos int64
+	for {
+		n, err := r.ReadAt(chunk, pos)
+		buf = append(buf, chunk[:n]...)
+		pos += int64(n)
+		if err == io.EOF {
+			return buf, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			return buf, nil
+		}
+	}
+}
+
+func extractTIFF(r io.ReaderAt) ([]byte, error) {
+	buf, err := readAllAt(r)
+	if err != nil {
+		return nil, fmt.Errorf("container: tiff: %w", err)
+	}
+	return buf, nil
+}
@@ -0,0 +1,279 @@
+package container
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// extractHEIF walks r's top-level ISO-BMFF boxes for "meta", then that
+// box's "iinf"/"iloc" children to locate the "Exif" item's absolute
+// (offset, length), without ever reading an unrelated sibling box (e.g.
+// a multi-GB "mdat"). It covers the infe v2/v3 and iloc v0/v1/v2 shapes
+// actually emitted by HEIF/HEIC encoders in practice, not the full
+// ISO/IEC 14496-12 generality.
+func extractHEIF(r io.ReaderAt) ([]byte, error) {
+	metaPayload, err := readTopLevelBox(r, "meta")
+	if err != nil {
+		return nil, fmt.Errorf("container: heif: %w", err)
+	}
+
+	itemID, err := findExifItemID(metaPayload)
+	if err != nil {
+		return nil, fmt.Errorf("container: heif: %w", err)
+	}
+	ilocPayload, ok := findChildBox(metaPayload, "iloc")
+	if !ok {
+		return nil, fmt.Errorf("container: heif: no iloc box found")
+	}
+	itemOffset, itemLength, err := findItemLocation(ilocPayload, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("container: heif: %w", err)
+	}
+
+	// Per ISO/IEC 23008-12, an Exif item's payload begins with a 4-byte
+	// big-endian offset to the actual TIFF header within it (normally 0,
+	// for an "Exif\x00\x00"-less embedding).
+	var tiffHdrOffsetBuf [4]byte
+	if _, err := r.ReadAt(tiffHdrOffsetBuf[:], int64(itemOffset)); err != nil {
+		return nil, fmt.Errorf("container: heif: reading Exif item header: %w", err)
+	}
+	tiffHdrOffset := int64(binary.BigEndian.Uint32(tiffHdrOffsetBuf[:]))
+	absOffset := int64(itemOffset) + 4 + tiffHdrOffset
+	absLength := int64(itemLength) - 4 - tiffHdrOffset
+	if absLength < 0 {
+		return nil, fmt.Errorf("container: heif: Exif item shorter than its tiff header offset")
+	}
+
+	payload := make([]byte, absLength)
+	if _, err := r.ReadAt(payload, absOffset); err != nil {
+		return nil, fmt.Errorf("container: heif: reading Exif item payload: %w", err)
+	}
+	return payload, nil
+}
+
+// readTopLevelBox scans r's sibling boxes, starting at offset 0, for one
+// of type want, reading only that box's payload into memory.
+func readTopLevelBox(r io.ReaderAt, want string) ([]byte, error) {
+	var pos int64
+	for {
+		var hdr [8]byte
+		if _, err := r.ReadAt(hdr[:], pos); err != nil {
+			return nil, fmt.Errorf("no %q box found", want)
+		}
+		boxSize := int64(binary.BigEndian.Uint32(hdr[0:4]))
+		typ := string(hdr[4:8])
+		bodyStart := pos + 8
+
+		if boxSize == 1 {
+			var ext [8]byte
+			if _, err := r.ReadAt(ext[:], bodyStart); err != nil {
+				return nil, fmt.Errorf("reading largesize for box %q: %w", typ, err)
+			}
+			boxSize = int64(binary.BigEndian.Uint64(ext[:]))
+			bodyStart += 8
+		}
+		if boxSize < bodyStart-pos {
+			return nil, fmt.Errorf("invalid size for box %q", typ)
+		}
+
+		if typ == want {
+			payload := make([]byte, pos+boxSize-bodyStart)
+			if _, err := r.ReadAt(payload, bodyStart); err != nil {
+				return nil, fmt.Errorf("reading box %q: %w", typ, err)
+			}
+			return payload, nil
+		}
+		pos += boxSize
+	}
+}
+
+// findChildBox scans a fullbox-prefixed payload (e.g. "meta"'s body,
+// which starts with a 4-byte version/flags field) for a direct child box
+// of type want.
+func findChildBox(metaPayload []byte, want string) ([]byte, bool) {
+	if len(metaPayload) < 4 {
+		return nil, false
+	}
+	pos := 4 // skip meta's own version/flags
+	for pos+8 <= len(metaPayload) {
+		boxSize := int(binary.BigEndian.Uint32(metaPayload[pos : pos+4]))
+		typ := string(metaPayload[pos+4 : pos+8])
+		if boxSize < 8 || pos+boxSize > len(metaPayload) {
+			return nil, false
+		}
+		if typ == want {
+			return metaPayload[pos+8 : pos+boxSize], true
+		}
+		pos += boxSize
+	}
+	return nil, false
+}
+
+// findExifItemID locates the "iinf" child box and returns the item_ID of
+// the entry whose item_type is "Exif".
+func findExifItemID(metaPayload []byte) (int, error) {
+	iinfPayload, ok := findChildBox(metaPayload, "iinf")
+	if !ok {
+		return 0, fmt.Errorf("no iinf box found")
+	}
+	if len(iinfPayload) < 6 {
+		return 0, fmt.Errorf("iinf box too short")
+	}
+	version := iinfPayload[0]
+	pos := 4 // version/flags
+	var entryCount int
+	if version == 0 {
+		entryCount = int(binary.BigEndian.Uint16(iinfPayload[pos : pos+2]))
+		pos += 2
+	} else {
+		entryCount = int(binary.BigEndian.Uint32(iinfPayload[pos : pos+4]))
+		pos += 4
+	}
+	for i := 0; i < entryCount && pos+8 <= len(iinfPayload); i++ {
+		boxSize := int(binary.BigEndian.Uint32(iinfPayload[pos : pos+4]))
+		typ := string(iinfPayload[pos+4 : pos+8])
+		if boxSize < 8 || pos+boxSize > len(iinfPayload) {
+			return 0, fmt.Errorf("invalid infe box size")
+		}
+		if typ == "infe" {
+			id, itemType, err := parseInfe(iinfPayload[pos+8 : pos+boxSize])
+			if err == nil && itemType == "Exif" {
+				return id, nil
+			}
+		}
+		pos += boxSize
+	}
+	return 0, fmt.Errorf("no Exif item found in iinf")
+}
+
+// parseInfe parses an "infe" fullbox body (version 2 or 3, the shapes
+// HEIF/HEIC encoders actually emit) into its item_ID and item_type.
+func parseInfe(payload []byte) (id int, itemType string, err error) {
+	if len(payload) < 4 {
+		return 0, "", fmt.Errorf("infe box too short")
+	}
+	version := payload[0]
+	pos := 4
+	switch version {
+	case 2:
+		if len(payload) < pos+6 {
+			return 0, "", fmt.Errorf("infe v2 box too short")
+		}
+		id = int(binary.BigEndian.Uint16(payload[pos : pos+2]))
+		itemType = string(payload[pos+4 : pos+8])
+	case 3:
+		if len(payload) < pos+12 {
+			return 0, "", fmt.Errorf("infe v3 box too short")
+		}
+		id = int(binary.BigEndian.Uint32(payload[pos : pos+4]))
+		itemType = string(payload[pos+8 : pos+12])
+	default:
+		return 0, "", fmt.Errorf("unsupported infe version %d", version)
+	}
+	return id, itemType, nil
+}
+
+// findItemLocation parses an "iloc" fullbox body (version 0, 1, or 2) for
+// the single-extent location of item itemID.
+func findItemLocation(payload []byte, itemID int) (offset, length int, err error) {
+	if len(payload) < 4 {
+		return 0, 0, fmt.Errorf("iloc box too short")
+	}
+	version := payload[0]
+	pos := 4
+
+	sizes, err := readUint(payload, pos, 2)
+	if err != nil {
+		return 0, 0, err
+	}
+	offsetSize := sizes >> 12 & 0xF
+	lengthSize := sizes >> 8 & 0xF
+	baseOffsetSize := sizes >> 4 & 0xF
+	pos += 2
+	if version == 1 || version == 2 {
+		pos += 2 // index_size
+	}
+
+	var itemCount int
+	if version < 2 {
+		itemCount, err = readUint(payload, pos, 2)
+		pos += 2
+	} else {
+		itemCount, err = readUint(payload, pos, 4)
+		pos += 4
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for i := 0; i < itemCount; i++ {
+		var id int
+		if version < 2 {
+			id, err = readUint(payload, pos, 2)
+			pos += 2
+		} else {
+			id, err = readUint(payload, pos, 4)
+			pos += 4
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+		if version == 1 || version == 2 {
+			pos += 2 // construction_method
+		}
+		pos += 2 // data_reference_index
+
+		baseOffset, err := readUint(payload, pos, baseOffsetSize)
+		if err != nil {
+			return 0, 0, err
+		}
+		pos += baseOffsetSize
+
+		extentCount, err := readUint(payload, pos, 2)
+		if err != nil {
+			return 0, 0, err
+		}
+		pos += 2
+
+		for e := 0; e < extentCount; e++ {
+			extOffset, err := readUint(payload, pos, offsetSize)
+			if err != nil {
+				return 0, 0, err
+			}
+			pos += offsetSize
+			extLength, err := readUint(payload, pos, lengthSize)
+			if err != nil {
+				return 0, 0, err
+			}
+			pos += lengthSize
+
+			if id == itemID {
+				return baseOffset + extOffset, extLength, nil
+			}
+		}
+	}
+	return 0, 0, fmt.Errorf("item %d not found in iloc", itemID)
+}
+
+// readUint reads a big-endian unsigned integer of size bytes (0, 2, 4, or
+// 8, per the iloc field-size encoding) from data at pos. size == 0 reads
+// as zero, matching iloc's convention for an omitted/implicit field.
+func readUint(data []byte, pos, size int) (int, error) {
+	if size == 0 {
+		return 0, nil
+	}
+	if pos+size > len(data) {
+		return 0, fmt.Errorf("iloc: truncated field")
+	}
+	switch size {
+	case 2:
+		return int(binary.BigEndian.Uint16(data[pos : pos+2])), nil
+	case 4:
+		return int(binary.BigEndian.Uint32(data[pos : pos+4])), nil
+	case 8:
+		return int(binary.BigEndian.Uint64(data[pos : pos+8])), nil
+	default:
+		return 0, fmt.Errorf("iloc: unsupported field size %d", size)
+	}
+}
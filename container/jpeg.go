@@ -0,0 +1,68 @@
+package container
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+const jpegAPP1 = 0xE1
+
+// extractJPEG walks r's JPEG markers looking for the APP1 segment carrying
+// the "Exif\x00\x00" prefix, stopping as soon as it's found or a
+// start-of-scan marker ends the header section (everything past it is
+// compressed image data, never read).
+func extractJPEG(r io.ReaderAt) ([]byte, error) {
+	var soi [2]byte
+	if _, err := r.ReadAt(soi[:], 0); err != nil {
+		return nil, fmt.Errorf("container: jpeg: reading SOI: %w", err)
+	}
+	if soi[0] != 0xFF || soi[1] != 0xD8 {
+		return nil, fmt.Errorf("container: jpeg: bad SOI marker")
+	}
+
+	pos := int64(2)
+	marker := make([]byte, 2)
+	for {
+		if _, err := r.ReadAt(marker, pos); err != nil {
+			return nil, fmt.Errorf("container: jpeg: %w", ErrNoExif)
+		}
+		if marker[0] != 0xFF {
+			pos++
+			continue
+		}
+		m := marker[1]
+		pos += 2
+		// Markers with no payload (SOI, EOI, RSTn) carry no length field.
+		if m == 0xD8 || m == 0xD9 || (m >= 0xD0 && m <= 0xD7) {
+			continue
+		}
+		if m == 0xDA { // start of scan: no more header markers follow
+			return nil, fmt.Errorf("container: jpeg: %w", ErrNoExif)
+		}
+
+		var lenBuf [2]byte
+		if _, err := r.ReadAt(lenBuf[:], pos); err != nil {
+			return nil, fmt.Errorf("container: jpeg: reading segment length: %w", err)
+		}
+		segLen := int64(binary.BigEndian.Uint16(lenBuf[:]))
+		if segLen < 2 {
+			return nil, fmt.Errorf("container: jpeg: invalid segment length %d", segLen)
+		}
+		dataStart := pos + 2
+		dataLen := segLen - 2
+
+		if m == jpegAPP1 && dataLen >= 6 {
+			hdr := make([]byte, 6)
+			if _, err := r.ReadAt(hdr, dataStart); err == nil && bytes.Equal(hdr, []byte("Exif\x00\x00")) {
+				payload := make([]byte, dataLen-6)
+				if _, err := r.ReadAt(payload, dataStart+6); err != nil {
+					return nil, fmt.Errorf("container: jpeg: reading APP1 payload: %w", err)
+				}
+				return payload, nil
+			}
+		}
+		pos = dataStart + dataLen
+	}
+}
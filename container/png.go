@@ -0,0 +1,152 @@
+package container
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// extractPNG walks r's chunks looking first for the modern "eXIf"
+// ancillary chunk (libpng >= 1.6.32), then for an ImageMagick-style
+// "tEXt" chunk under the keyword "Raw profile type exif" or
+// "Raw profile type APP1", which hex-encodes the EXIF blob as text:
+//
+//	\n<profile name>\n<length, decimal>\n<hex, wrapped>
+func extractPNG(r io.ReaderAt) ([]byte, error) {
+	var sig [8]byte
+	if _, err := r.ReadAt(sig[:], 0); err != nil {
+		return nil, fmt.Errorf("container: png: reading signature: %w", err)
+	}
+	if !bytes.Equal(sig[:], pngSignature) {
+		return nil, fmt.Errorf("container: png: bad signature")
+	}
+
+	pos := int64(8)
+	var textFallback []byte
+	for {
+		var lenBuf [4]byte
+		if _, err := r.ReadAt(lenBuf[:], pos); err != nil {
+			break
+		}
+		length := int64(binary.BigEndian.Uint32(lenBuf[:]))
+
+		var typ [4]byte
+		if _, err := r.ReadAt(typ[:], pos+4); err != nil {
+			break
+		}
+
+		dataStart := pos + 8
+		data := make([]byte, length)
+		if length > 0 {
+			if _, err := r.ReadAt(data, dataStart); err != nil {
+				break
+			}
+		}
+
+		switch string(typ[:]) {
+		case "eXIf":
+			return data, nil
+		case "tEXt":
+			if exif, ok := decodeImageMagickExifProfile(data); ok {
+				textFallback = exif
+			}
+		case "iTXt":
+			if exif, ok := decodeITXtExifProfile(data); ok {
+				textFallback = exif
+			}
+		case "IEND":
+			if textFallback != nil {
+				return textFallback, nil
+			}
+			return nil, fmt.Errorf("container: png: %w", ErrNoExif)
+		}
+
+		pos = dataStart + length + 4 // skip CRC
+	}
+	if textFallback != nil {
+		return textFallback, nil
+	}
+	return nil, fmt.Errorf("container: png: %w", ErrNoExif)
+}
+
+// decodeImageMagickExifProfile decodes a "tEXt" chunk's payload, keyed by
+// a NUL-terminated keyword, if that keyword names a raw EXIF profile.
+func decodeImageMagickExifProfile(data []byte) ([]byte, bool) {
+	nul := bytes.IndexByte(data, 0)
+	if nul < 0 {
+		return nil, false
+	}
+	keyword := string(data[:nul])
+	if keyword != "Raw profile type exif" && keyword != "Raw profile type APP1" {
+		return nil, false
+	}
+
+	return decodeImageMagickExifProfileText(string(data[nul+1:]))
+}
+
+// decodeITXtExifProfile decodes an "iTXt" chunk the same way as
+// decodeImageMagickExifProfile, accounting for iTXt's extra
+// compression-flag/compression-method/language-tag/translated-keyword
+// fields ahead of the text itself, and for the text being zlib-compressed
+// when the compression flag is set.
+func decodeITXtExifProfile(data []byte) ([]byte, bool) {
+	nul := bytes.IndexByte(data, 0)
+	if nul < 0 {
+		return nil, false
+	}
+	keyword := string(data[:nul])
+	if keyword != "Raw profile type exif" && keyword != "Raw profile type APP1" {
+		return nil, false
+	}
+	rest := data[nul+1:]
+	if len(rest) < 2 {
+		return nil, false
+	}
+	compressed := rest[0] == 1
+	rest = rest[2:] // skip compression flag, compression method
+
+	nul = bytes.IndexByte(rest, 0)
+	if nul < 0 {
+		return nil, false
+	}
+	rest = rest[nul+1:] // skip language tag
+
+	nul = bytes.IndexByte(rest, 0)
+	if nul < 0 {
+		return nil, false
+	}
+	text := rest[nul+1:] // skip translated keyword
+
+	if compressed {
+		zr, err := zlib.NewReader(bytes.NewReader(text))
+		if err != nil {
+			return nil, false
+		}
+		defer zr.Close()
+		decompressed, err := io.ReadAll(zr)
+		if err != nil {
+			return nil, false
+		}
+		text = decompressed
+	}
+	return decodeImageMagickExifProfileText(string(text))
+}
+
+// decodeImageMagickExifProfileText decodes the "\n<name>\n<length>\n<hex>"
+// body shared by tEXt and iTXt "Raw profile type exif" encodings.
+func decodeImageMagickExifProfileText(s string) ([]byte, bool) {
+	lines := strings.Split(s, "\n")
+	var hexDigits strings.Builder
+	for i := 3; i < len(lines); i++ {
+		hexDigits.WriteString(strings.TrimSpace(lines[i]))
+	}
+	raw, err := hex.DecodeString(hexDigits.String())
+	if err != nil {
+		return nil, false
+	}
+	return raw, true
+}
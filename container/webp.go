@@ -0,0 +1,46 @@
+package container
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// extractWebP walks r's RIFF chunks for the "EXIF" chunk carrying a
+// WebP file's embedded EXIF/TIFF blob.
+func extractWebP(r io.ReaderAt) ([]byte, error) {
+	var riffHdr [12]byte
+	if _, err := r.ReadAt(riffHdr[:], 0); err != nil {
+		return nil, fmt.Errorf("container: webp: reading RIFF header: %w", err)
+	}
+	if !bytes.Equal(riffHdr[0:4], []byte("RIFF")) || !bytes.Equal(riffHdr[8:12], []byte("WEBP")) {
+		return nil, fmt.Errorf("container: webp: not a RIFF/WEBP file")
+	}
+
+	pos := int64(12)
+	for {
+		var chunkHdr [8]byte
+		if _, err := r.ReadAt(chunkHdr[:], pos); err != nil {
+			return nil, fmt.Errorf("container: webp: %w", ErrNoExif)
+		}
+		fourCC := string(chunkHdr[0:4])
+		size := int64(binary.LittleEndian.Uint32(chunkHdr[4:8]))
+		dataStart := pos + 8
+
+		if fourCC == "EXIF" {
+			data := make([]byte, size)
+			if _, err := r.ReadAt(data, dataStart); err != nil {
+				return nil, fmt.Errorf("container: webp: reading EXIF chunk: %w", err)
+			}
+			return data, nil
+		}
+
+		// Chunks are padded to an even number of bytes.
+		padded := size
+		if padded%2 == 1 {
+			padded++
+		}
+		pos = dataStart + padded
+	}
+}
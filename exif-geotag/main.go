@@ -0,0 +1,147 @@
+// Command exif-geotag writes GPS EXIF tags into a JPEG by correlating its
+// DateTimeOriginal against one or more GPX/NMEA/KML track logs.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rpajarola/exiftools/exif"
+	"github.com/rpajarola/exiftools/geotag"
+	_ "github.com/rpajarola/exiftools/mknote"
+)
+
+// trackFlag collects repeated -track flags into a slice.
+type trackFlag []string
+
+func (t *trackFlag) String() string     { return strings.Join(*t, ",") }
+func (t *trackFlag) Set(v string) error { *t = append(*t, v); return nil }
+
+func main() {
+	var tracks trackFlag
+	flag.Var(&tracks, "track", "GPX/NMEA/KML track log (repeatable)")
+	offset := flag.Duration("offset", 0, "camera clock's offset from UTC (local = UTC + offset)")
+	maxGap := flag.Duration("maxgap", 5*time.Minute, "largest gap to interpolate across before refusing to tag")
+	mode := flag.String("mode", "linear", "interpolation mode: linear or greatcircle")
+	out := flag.String("o", "", "output path (required unless -overwrite is set)")
+	overwrite := flag.Bool("overwrite", false, "write the tagged EXIF back into the source file")
+	flag.Parse()
+
+	if len(tracks) == 0 {
+		fmt.Fprintln(os.Stderr, "exif-geotag: at least one -track is required")
+		os.Exit(1)
+	}
+	if *out == "" && !*overwrite {
+		fmt.Fprintln(os.Stderr, "exif-geotag: either -o or -overwrite is required")
+		os.Exit(1)
+	}
+
+	interpolation := geotag.Linear
+	switch *mode {
+	case "linear":
+	case "greatcircle":
+		interpolation = geotag.GreatCircle
+	default:
+		fmt.Fprintf(os.Stderr, "exif-geotag: unknown -mode %q (want linear or greatcircle)\n", *mode)
+		os.Exit(1)
+	}
+
+	track, err := loadTracks(tracks)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "exif-geotag: %v\n", err)
+		os.Exit(1)
+	}
+
+	cfg := geotag.Config{Offset: *offset, MaxGap: *maxGap, Interpolation: interpolation}
+
+	for _, fname := range flag.Args() {
+		if err := tagFile(fname, track, cfg, *out, *overwrite); err != nil {
+			fmt.Fprintf(os.Stderr, "exif-geotag: %s: %v\n", fname, err)
+			os.Exit(1)
+		}
+	}
+}
+
+// loadTracks parses every track log named in names (sniffing by
+// extension) and merges them into one Track.
+func loadTracks(names []string) (geotag.Track, error) {
+	var all geotag.Track
+	for _, name := range names {
+		f, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		var t geotag.Track
+		switch {
+		case strings.HasSuffix(strings.ToLower(name), ".gpx"):
+			t, err = geotag.ParseGPX(f)
+		case strings.HasSuffix(strings.ToLower(name), ".kml"):
+			t, err = geotag.ParseKML(f)
+		default:
+			t, err = geotag.ParseNMEA(f)
+		}
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("loading track %s: %w", name, err)
+		}
+		all = append(all, t...)
+	}
+	return geotag.Merge(all), nil
+}
+
+// tagFile decodes fname's EXIF, tags it against track, and writes the
+// result to out (or back to fname, if overwrite is set).
+func tagFile(fname string, track geotag.Track, cfg geotag.Config, out string, overwrite bool) error {
+	src, err := os.Open(fname)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	x, err := exif.Decode(src)
+	if err != nil {
+		return fmt.Errorf("decoding EXIF: %w", err)
+	}
+
+	capturedAt, err := x.DateTime()
+	if err != nil {
+		return fmt.Errorf("reading DateTimeOriginal: %w", err)
+	}
+	if err := geotag.Tag(x, capturedAt, track, cfg); err != nil {
+		return err
+	}
+
+	dstPath := out
+	if overwrite {
+		dstPath = fname
+	}
+	tmp, err := os.CreateTemp(dirOf(dstPath), "exif-geotag-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := src.Seek(0, 0); err != nil {
+		return err
+	}
+	if err := x.WriteJPEG(tmp, src); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing tagged JPEG: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), dstPath)
+}
+
+// dirOf returns the directory os.CreateTemp should place its scratch
+// file in, so the final os.Rename stays within one filesystem.
+func dirOf(path string) string {
+	if idx := strings.LastIndexByte(path, '/'); idx >= 0 {
+		return path[:idx]
+	}
+	return "."
+}
@@ -155,7 +155,7 @@ func (x *Exif) GetStrings(fields ...models.FieldName) (string, error) {
 	var ok bool
 	var a *tiff.Tag
 	for _, field := range fields {
-		if a, ok = x.main[field]; ok {
+		if a, ok = x.Fields[field]; ok {
 			break
 		}
 	}
@@ -176,7 +176,7 @@ func (x *Exif) GetUints(fields ...models.FieldName) (uint, error) {
 	var ok bool
 	var a *tiff.Tag
 	for _, field := range fields {
-		if a, ok = x.main[field]; ok {
+		if a, ok = x.Fields[field]; ok {
 			break
 		}
 	}
@@ -300,3 +300,37 @@ func (x *Exif) FocalLength(fn models.FieldName) (fl float32, err error) {
 	}
 	return 0, fmt.Errorf("cannot parse FocalLength")
 }
+
+// shutterCountFields lists the maker-note shutter-count tags GetShutterCount
+// checks, in order. They're referenced as literal FieldName strings rather
+// than through the mknote package's exported vars, since mknote imports
+// exif and importing it back here would cycle (the same reason TimeZone
+// below reads "Canon.TimeInfo" as a literal).
+var shutterCountFields = []models.FieldName{
+	"Sony.ShutterCount3",
+	"Sony.ShutterCount2",
+	"Sony.ShutterCount",
+	"Panasonic.ShutterCount",
+	"Nikon.ShutterCount",
+}
+
+// GetShutterCount - Get the camera body's shutter actuation count from
+// whichever maker-note field the decoding vendor parser populated.
+//
+// Canon has no plain shutter-count tag: its count lives inside the opaque
+// CanonShotInfo binary block, decoded separately via
+// mknote.CanonRaw.CanonShotInfo, so it isn't available here.
+func (x *Exif) GetShutterCount() (uint, error) {
+	for _, name := range shutterCountFields {
+		tag, err := x.Get(name)
+		if err != nil {
+			continue
+		}
+		count, err := tag.Int(0)
+		if err != nil {
+			continue
+		}
+		return uint(count), nil
+	}
+	return 0, fmt.Errorf("no shutter count maker-note field present")
+}
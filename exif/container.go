@@ -0,0 +1,168 @@
+package exif
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ContainerParser locates the raw EXIF/TIFF blob inside a particular file
+// container format (JPEG, PNG, WebP, HEIF/AVIF, ...) and reports the byte
+// order the caller should use to decode it. Decode sniffs the first bytes
+// of the input against every registered container's Sniff function and
+// dispatches to the first match.
+type ContainerParser interface {
+	// Name identifies the container for error messages and debugging.
+	Name() string
+	// Sniff reports whether header (at least 12 bytes, zero-padded if the
+	// input is shorter) looks like this container format.
+	Sniff(header []byte) bool
+	// ParseContainer extracts the raw EXIF TIFF bytes (without any "Exif\0\0"
+	// prefix) from r, which starts at the beginning of the file.
+	ParseContainer(r io.Reader) (exifBytes []byte, order binary.ByteOrder, err error)
+}
+
+var containers []ContainerParser
+
+// RegisterContainer registers a container format so Decode can recognize
+// and extract EXIF data from it. Built-in containers (JPEG, TIFF, raw EXIF,
+// HEIF/HEIC) are registered by this package's init; callers can add more
+// (e.g. AVIF, PNG, WebP) or override sniffing order by registering before
+// decoding any files.
+func RegisterContainer(c ContainerParser) {
+	containers = append(containers, c)
+}
+
+func init() {
+	RegisterContainer(pngContainer{})
+	RegisterContainer(webpContainer{})
+}
+
+// ErrNoContainerMatch is returned by sniffContainer when no registered
+// ContainerParser recognizes the input header.
+var ErrNoContainerMatch = errors.New("exif: no registered container format recognized the input")
+
+// sniffContainer returns the first registered ContainerParser whose Sniff
+// function matches header, or ErrNoContainerMatch if none do.
+func sniffContainer(header []byte) (ContainerParser, error) {
+	for _, c := range containers {
+		if c.Sniff(header) {
+			return c, nil
+		}
+	}
+	return nil, ErrNoContainerMatch
+}
+
+// pngContainer extracts the "eXIf" ancillary chunk from a PNG file, as
+// specified by the PNG eXIf chunk extension (used by libpng >= 1.6.32 and
+// written by some cameras/phones and image editors that embed EXIF in PNG).
+type pngContainer struct{}
+
+func (pngContainer) Name() string { return "png" }
+
+func (pngContainer) Sniff(header []byte) bool {
+	return len(header) >= len(pngSignature) && bytes.Equal(header[:len(pngSignature)], pngSignature)
+}
+
+func (pngContainer) ParseContainer(r io.Reader) ([]byte, binary.ByteOrder, error) {
+	br := bufio.NewReader(r)
+	var sig [8]byte
+	if _, err := io.ReadFull(br, sig[:]); err != nil {
+		return nil, nil, fmt.Errorf("exif: png: reading signature: %w", err)
+	}
+	if !bytes.Equal(sig[:], pngSignature) {
+		return nil, nil, fmt.Errorf("exif: png: bad signature")
+	}
+	for {
+		var lenBuf [4]byte
+		if _, err := io.ReadFull(br, lenBuf[:]); err != nil {
+			return nil, nil, fmt.Errorf("exif: png: no eXIf chunk found: %w", err)
+		}
+		length := binary.BigEndian.Uint32(lenBuf[:])
+
+		var typ [4]byte
+		if _, err := io.ReadFull(br, typ[:]); err != nil {
+			return nil, nil, fmt.Errorf("exif: png: reading chunk type: %w", err)
+		}
+
+		data := make([]byte, length)
+		if _, err := io.ReadFull(br, data); err != nil {
+			return nil, nil, fmt.Errorf("exif: png: reading chunk data: %w", err)
+		}
+		// skip CRC
+		var crc [4]byte
+		if _, err := io.ReadFull(br, crc[:]); err != nil {
+			return nil, nil, fmt.Errorf("exif: png: reading chunk crc: %w", err)
+		}
+
+		switch string(typ[:]) {
+		case "eXIf":
+			return sniffByteOrder(data)
+		case "IEND":
+			return nil, nil, fmt.Errorf("exif: png: no eXIf chunk found")
+		}
+	}
+}
+
+// webpContainer extracts the "EXIF" chunk from a WebP file's RIFF
+// container.
+type webpContainer struct{}
+
+func (webpContainer) Name() string { return "webp" }
+
+func (webpContainer) Sniff(header []byte) bool {
+	return len(header) >= 12 && bytes.Equal(header[0:4], []byte("RIFF")) && bytes.Equal(header[8:12], []byte("WEBP"))
+}
+
+func (webpContainer) ParseContainer(r io.Reader) ([]byte, binary.ByteOrder, error) {
+	br := bufio.NewReader(r)
+	var riffHdr [12]byte
+	if _, err := io.ReadFull(br, riffHdr[:]); err != nil {
+		return nil, nil, fmt.Errorf("exif: webp: reading RIFF header: %w", err)
+	}
+	if !bytes.Equal(riffHdr[0:4], []byte("RIFF")) || !bytes.Equal(riffHdr[8:12], []byte("WEBP")) {
+		return nil, nil, fmt.Errorf("exif: webp: not a RIFF/WEBP file")
+	}
+	for {
+		var chunkHdr [8]byte
+		if _, err := io.ReadFull(br, chunkHdr[:]); err != nil {
+			return nil, nil, fmt.Errorf("exif: webp: no EXIF chunk found: %w", err)
+		}
+		fourCC := string(chunkHdr[0:4])
+		size := binary.LittleEndian.Uint32(chunkHdr[4:8])
+		// Chunks are padded to an even number of bytes.
+		padded := size
+		if padded%2 == 1 {
+			padded++
+		}
+
+		data := make([]byte, padded)
+		if _, err := io.ReadFull(br, data); err != nil {
+			return nil, nil, fmt.Errorf("exif: webp: reading %q chunk: %w", fourCC, err)
+		}
+
+		if fourCC == "EXIF" {
+			return sniffByteOrder(data[:size])
+		}
+	}
+}
+
+// sniffByteOrder inspects the TIFF byte-order marker at the start of data
+// and returns the raw EXIF bytes (data itself) paired with the order to
+// decode it with.
+func sniffByteOrder(data []byte) ([]byte, binary.ByteOrder, error) {
+	if len(data) < 2 {
+		return nil, nil, fmt.Errorf("exif: container EXIF chunk too short")
+	}
+	switch string(data[0:2]) {
+	case "II":
+		return data, binary.LittleEndian, nil
+	case "MM":
+		return data, binary.BigEndian, nil
+	default:
+		return nil, nil, fmt.Errorf("exif: container EXIF chunk has unrecognized byte order marker %q", data[0:2])
+	}
+}
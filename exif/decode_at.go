@@ -0,0 +1,189 @@
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/rpajarola/exiftools/tiff"
+)
+
+// DecodeAt parses EXIF data from ra (a TIFF, JPEG, HEIF/AVIF, or raw EXIF
+// blob of the given size) using an io.ReaderAt as the backing store instead
+// of buffering the whole input via io.ReadAll, as DecodeWithOptions does.
+// The container/box structure is walked directly against ra so that only
+// the TIFF/EXIF payload itself (never the whole file, e.g. a HEIF's mdat
+// pixel data) is read into memory. This lets callers such as photo-library
+// indexers scan many files without allocating a full-size buffer per file.
+//
+// Note: once the TIFF payload's offset and length are located, its bytes
+// are still read into x.Raw up front (capped at opts.MaxExifSize): tag
+// values are not lazily fetched per-access, since tiff.Tag has no
+// deferred/offset-backed value representation in this tree. DecodeAt's
+// saving is in never touching the bytes outside the EXIF/TIFF block.
+func DecodeAt(ra io.ReaderAt, size int64, opts *DecodeOptions) (*Exif, error) {
+	if opts == nil {
+		opts = &DecodeOptions{}
+	}
+	if opts.MaxExifSize <= 0 {
+		opts.MaxExifSize = exifLengthCutoff
+	}
+
+	header := make([]byte, 16)
+	n, err := ra.ReadAt(header, 0)
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("exif: DecodeAt: error reading header: %v", err)
+	}
+	header = header[:n]
+	fType := detectFileType(header)
+
+	var tiffOffset, tiffLength int64
+	switch fType {
+	case fileTypeTIFF:
+		tiffOffset, tiffLength = 0, size
+	case fileTypeRawExif:
+		tiffOffset, tiffLength = 6, size-6
+	case fileTypeJPEG:
+		tiffOffset, tiffLength, err = locateJPEGApp1At(ra, size)
+	case fileTypeHEIF, fileTypeAVIF:
+		tiffOffset, tiffLength, err = locateISOBMFFExifAt(ra, size)
+	default:
+		return nil, fmt.Errorf("exif: DecodeAt: streaming decode is not supported for this file type, use DecodeWithOptions instead")
+	}
+	if err != nil {
+		return nil, decodeError{cause: err}
+	}
+
+	if tiffLength > int64(opts.MaxExifSize) {
+		tiffLength = int64(opts.MaxExifSize)
+	}
+	tif, err := tiff.Decode(io.NewSectionReader(ra, tiffOffset, tiffLength))
+	if err != nil {
+		return nil, decodeError{cause: err}
+	}
+	raw, err := io.ReadAll(io.NewSectionReader(ra, tiffOffset, tiffLength))
+	if err != nil {
+		return nil, decodeError{cause: err}
+	}
+
+	x := New(tif, raw, opts)
+	if errs := runParsers(x); errs != nil {
+		return x, errs
+	}
+	return x, nil
+}
+
+// locateJPEGApp1At walks JPEG markers directly against ra (rather than
+// through an io.Reader, as newAppSec does) to find the APP1/EXIF segment's
+// absolute (offset, length), stopping as soon as it's found or a
+// start-of-scan marker ends the header section.
+func locateJPEGApp1At(ra io.ReaderAt, size int64) (offset, length int64, err error) {
+	var pos int64
+	marker := make([]byte, 2)
+	for pos+4 <= size {
+		if _, err := ra.ReadAt(marker, pos); err != nil {
+			return 0, 0, err
+		}
+		if marker[0] != 0xFF {
+			pos++
+			continue
+		}
+		m := marker[1]
+		pos += 2
+		// Markers with no payload (SOI, EOI, RSTn) carry no length field.
+		if m == 0xD8 || m == 0xD9 || (m >= 0xD0 && m <= 0xD7) {
+			continue
+		}
+		if pos+2 > size {
+			break
+		}
+		var lenBuf [2]byte
+		if _, err := ra.ReadAt(lenBuf[:], pos); err != nil {
+			return 0, 0, err
+		}
+		segLen := int64(binary.BigEndian.Uint16(lenBuf[:]))
+		dataStart := pos + 2
+		dataLen := segLen - 2
+		if m == jpegAPP1 && dataLen >= 6 {
+			hdr := make([]byte, 6)
+			if _, err := ra.ReadAt(hdr, dataStart); err == nil && bytes.Equal(hdr, []byte("Exif\x00\x00")) {
+				return dataStart + 6, dataLen - 6, nil
+			}
+		}
+		if m == 0xDA { // start of scan: no more header markers follow
+			break
+		}
+		pos = dataStart + dataLen
+	}
+	return 0, 0, fmt.Errorf("exif: failed to find exif intro marker")
+}
+
+// locateISOBMFFExifAt walks ra's top-level ISO-BMFF boxes to find "meta",
+// then its "iinf"/"iloc" children (via the same isobmffExifItemLocation
+// helper processAVIFFile uses) to locate the Exif item's absolute
+// (offset, length), without reading any sibling box such as "mdat".
+func locateISOBMFFExifAt(ra io.ReaderAt, size int64) (offset, length int64, err error) {
+	metaPayload, err := readTopLevelISOBMFFBoxAt(ra, size, "meta")
+	if err != nil {
+		return 0, 0, err
+	}
+	itemOffset, itemLength, err := isobmffExifItemLocation(metaPayload)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var tiffHdrOffsetBuf [4]byte
+	if _, err := ra.ReadAt(tiffHdrOffsetBuf[:], int64(itemOffset)); err != nil {
+		return 0, 0, fmt.Errorf("exif: isobmff: reading Exif item header: %w", err)
+	}
+	tiffHdrOffset := int64(binary.BigEndian.Uint32(tiffHdrOffsetBuf[:]))
+	absOffset := int64(itemOffset) + 4 + tiffHdrOffset
+	absLength := int64(itemLength) - 4 - tiffHdrOffset
+	if absLength < 0 {
+		return 0, 0, fmt.Errorf("exif: isobmff: Exif item shorter than its tiff header offset")
+	}
+	return absOffset, absLength, nil
+}
+
+// readTopLevelISOBMFFBoxAt scans ra's sibling boxes for one of type want,
+// reading only that box's payload into memory (box headers are read 8 or
+// 16 bytes at a time; every other sibling, e.g. a multi-GB "mdat", is
+// skipped over via its declared size rather than read).
+func readTopLevelISOBMFFBoxAt(ra io.ReaderAt, size int64, want string) ([]byte, error) {
+	var pos int64
+	for pos+8 <= size {
+		var hdr [8]byte
+		if _, err := ra.ReadAt(hdr[:], pos); err != nil {
+			return nil, err
+		}
+		boxSize := int64(binary.BigEndian.Uint32(hdr[0:4]))
+		typ := string(hdr[4:8])
+		bodyStart := pos + 8
+
+		switch boxSize {
+		case 1:
+			var ext [8]byte
+			if _, err := ra.ReadAt(ext[:], bodyStart); err != nil {
+				return nil, err
+			}
+			boxSize = int64(binary.BigEndian.Uint64(ext[:]))
+			bodyStart += 8
+		case 0:
+			boxSize = size - pos
+		}
+		if boxSize < bodyStart-pos || pos+boxSize > size {
+			return nil, fmt.Errorf("exif: isobmff: invalid size for box %q", typ)
+		}
+
+		if typ == want {
+			payload := make([]byte, pos+boxSize-bodyStart)
+			if _, err := ra.ReadAt(payload, bodyStart); err != nil {
+				return nil, err
+			}
+			return payload, nil
+		}
+		pos += boxSize
+	}
+	return nil, fmt.Errorf("exif: isobmff: no %q box found", want)
+}
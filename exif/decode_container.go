@@ -0,0 +1,52 @@
+package exif
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/rpajarola/exiftools/container"
+	"github.com/rpajarola/exiftools/tiff"
+)
+
+// DecodeContainer parses EXIF data from ra using the container package's
+// format sniffing and raw-extraction logic, rather than this package's own
+// detectFileType/processXXXFile switch. It exists alongside
+// Decode/DecodeWithOptions/DecodeAt (not in place of them) as the entry
+// point for callers that already depend on container.Format — most
+// notably maker-note parsers and indexers that want one code path across
+// JPEG, PNG, WebP, HEIF/HEIC, and TIFF-based RAW formats instead of
+// special-casing JPEG.
+func DecodeContainer(ra io.ReaderAt, opts *DecodeOptions) (*Exif, error) {
+	return decodeContainerHint(ra, container.FormatUnknown, opts)
+}
+
+// decodeContainerHint is DecodeContainer with an explicit container.Format,
+// skipping the sniff, for callers that already know ra's format (e.g.
+// from a file extension).
+func decodeContainerHint(ra io.ReaderAt, hint container.Format, opts *DecodeOptions) (*Exif, error) {
+	if opts == nil {
+		opts = &DecodeOptions{}
+	}
+	if opts.MaxExifSize <= 0 {
+		opts.MaxExifSize = exifLengthCutoff
+	}
+
+	raw, err := container.ExtractRawExif(ra, hint)
+	if err != nil {
+		return nil, decodeError{cause: err}
+	}
+	if int64(len(raw)) > int64(opts.MaxExifSize) {
+		raw = raw[:opts.MaxExifSize]
+	}
+
+	tif, err := tiff.Decode(bytes.NewReader(raw))
+	if err != nil {
+		return nil, decodeError{cause: err}
+	}
+
+	x := New(tif, raw, opts)
+	if errs := runParsers(x); errs != nil {
+		return x, errs
+	}
+	return x, nil
+}
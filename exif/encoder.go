@@ -0,0 +1,166 @@
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/rpajarola/exiftools/models"
+	"github.com/rpajarola/exiftools/tiff"
+)
+
+// Encoder is implemented by parsers (e.g. in the mknote package) that can
+// turn the fields they previously loaded via Parse back into an IFD that
+// Encode can splice into the serialized TIFF tree. A parser that has no
+// writable state, or that only ever reads, is not required to implement it.
+type Encoder interface {
+	// Encode returns the sub-IFD this parser contributed, rebuilt from the
+	// current values of the fields it owns in x.Fields. A nil Dir means the
+	// parser has nothing to contribute (e.g. the source file was never of
+	// its maker).
+	Encode(x *Exif) (*tiff.Dir, error)
+}
+
+// Set stores tag into the field name, overwriting any previous value
+// (including one that was never present). Unlike Update, Set never fails:
+// it is the entry point for callers building up an Exif from scratch or
+// mutating one prior to Encode.
+func (x *Exif) Set(name models.FieldName, tag *tiff.Tag) {
+	x.Fields[name] = tag
+}
+
+// Delete removes the field with the given name, if present. It is a no-op
+// if the field does not exist.
+func (x *Exif) Delete(name models.FieldName) {
+	delete(x.Fields, name)
+}
+
+// Encode serializes x back into a standalone TIFF stream (byte-order
+// preserved from the original decode) and writes it to w. Sub-IFDs
+// (Exif, GPS, Interoperability) are re-emitted from whatever fields are
+// still present under their respective FieldName prefixes, and any
+// registered Encoder parsers are consulted for maker-note sub-IFDs.
+//
+// Encode does not attempt to recompute derived fields (e.g. thumbnail
+// dimensions); callers are responsible for keeping related tags consistent
+// before calling it.
+func (x *Exif) Encode(w io.Writer) error {
+	if x.Tiff == nil {
+		return fmt.Errorf("exif: cannot encode without a decoded tiff structure")
+	}
+	order := x.Tiff.Order
+
+	dir := fieldsToDir(x.Fields, models.ExifFields)
+
+	exifSub := fieldsToDir(x.Fields, models.ExifFields)
+	makerNote, err := encodeMakerNote(x, order)
+	if err != nil {
+		return err
+	}
+	if makerNote != nil {
+		exifSub.Tags = append(exifSub.Tags, makerNote)
+	}
+	if len(exifSub.Tags) > 0 {
+		dir.SubDirs = append(dir.SubDirs, tiff.SubDir{Pointer: exifIFDPointerID, Dir: exifSub})
+	}
+
+	if err := attachSubDir(x, dir, gpsInfoIFDPointerID, models.GpsFields); err != nil {
+		return err
+	}
+	if err := attachSubDir(x, dir, interoperabilityIFDPointerID, models.InteropFields); err != nil {
+		return err
+	}
+
+	_, err = tiff.EncodeDir(w, dir, order)
+	return err
+}
+
+// Standard EXIF/TIFF pointer and MakerNote tag IDs, living in the IFDs
+// fieldsToDir/attachSubDir build from models.ExifFields/GpsFields/
+// InteropFields. tiff.Dir/SubDir key sub-IFDs by numeric tag ID rather than
+// models.FieldName, so the tiff package stays independent of models.
+const (
+	exifIFDPointerID             = 0x8769
+	gpsInfoIFDPointerID          = 0x8825
+	interoperabilityIFDPointerID = 0xA005
+	makerNoteTagID               = 0x927C
+)
+
+// encodeMakerNote consults every registered Encoder parser for a
+// maker-note sub-IFD (a file has at most one maker note, so the first
+// parser to contribute one wins) and, if one does, serializes it to its
+// own raw IFD bytes and wraps them as the actual MakerNote tag - matching
+// what Decode originally read - rather than flattening the vendor's tags
+// into the parent IFD the way splicing sub.Tags in directly would.
+func encodeMakerNote(x *Exif, order binary.ByteOrder) (*tiff.Tag, error) {
+	for _, p := range parsers {
+		enc, ok := p.(Encoder)
+		if !ok {
+			continue
+		}
+		sub, err := enc.Encode(x)
+		if err != nil {
+			return nil, fmt.Errorf("exif: encoder %T failed: %w", p, err)
+		}
+		if sub == nil {
+			continue
+		}
+		var buf bytes.Buffer
+		if _, err := tiff.EncodeDir(&buf, sub, order); err != nil {
+			return nil, fmt.Errorf("exif: encoding maker note from %T: %w", p, err)
+		}
+		return tiff.MakeTag(makerNoteTagID, tiff.DTUndefined, uint32(buf.Len()), order, buf.Bytes()), nil
+	}
+	return nil, nil
+}
+
+// EncodeJPEG writes x as a JPEG APP1 EXIF segment (the "Exif\x00\x00"
+// header followed by the TIFF stream), suitable for splicing back into a
+// JPEG file in place of the APP1 segment Decode originally read.
+func (x *Exif) EncodeJPEG(w io.Writer) error {
+	var buf bytes.Buffer
+	buf.WriteString("Exif\x00\x00")
+	if err := x.Encode(&buf); err != nil {
+		return err
+	}
+	if buf.Len() > 0xFFFF-2 {
+		return fmt.Errorf("exif: encoded APP1 segment too large (%d bytes)", buf.Len())
+	}
+	var hdr [4]byte
+	hdr[0] = 0xFF
+	hdr[1] = jpegAPP1
+	binary.BigEndian.PutUint16(hdr[2:], uint16(buf.Len()+2))
+	if _, err := w.Write(hdr[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// fieldsToDir collects every field in fields that appears in fieldMap into
+// a new *tiff.Dir, keyed back to its original tag ID.
+func fieldsToDir(fields map[models.FieldName]*tiff.Tag, fieldMap map[uint16]models.FieldName) *tiff.Dir {
+	dir := &tiff.Dir{}
+	for id, name := range fieldMap {
+		tag, ok := fields[name]
+		if !ok {
+			continue
+		}
+		tag.Id = id
+		dir.Tags = append(dir.Tags, tag)
+	}
+	return dir
+}
+
+// attachSubDir re-encodes the sub-IFD addressed by ptr (if any of its
+// fields are still present) and appends a pointer tag for it to dir. The
+// actual offset is resolved by tiff.EncodeDir when the parent is written.
+func attachSubDir(x *Exif, dir *tiff.Dir, ptr uint16, fieldMap map[uint16]models.FieldName) error {
+	sub := fieldsToDir(x.Fields, fieldMap)
+	if len(sub.Tags) == 0 {
+		return nil
+	}
+	dir.SubDirs = append(dir.SubDirs, tiff.SubDir{Pointer: ptr, Dir: sub})
+	return nil
+}
@@ -24,8 +24,164 @@ func (de decodeError) Error() string {
 	return fmt.Sprintf("exif: decode failed (%v) ", de.cause.Error())
 }
 
+func (de decodeError) Unwrap() error {
+	return de.cause
+}
+
+// CriticalError wraps an error that prevented any EXIF data from being
+// decoded at all (the container/TIFF structure itself could not be parsed),
+// as opposed to a failure confined to one sub-IFD.
+type CriticalError struct{ cause error }
+
+func (e CriticalError) Error() string { return fmt.Sprintf("exif: critical error: %v", e.cause) }
+func (e CriticalError) Unwrap() error { return e.cause }
+
+// ExifError wraps a failure loading the EXIF sub-IFD. The *Exif returned
+// alongside it still has whatever IFD0/thumbnail/GPS/Interop fields loaded
+// successfully.
+type ExifError struct{ cause error }
+
+func (e ExifError) Error() string { return fmt.Sprintf("exif: loading EXIF sub-IFD: %v", e.cause) }
+func (e ExifError) Unwrap() error { return e.cause }
+
+// GPSError wraps a failure loading the GPS sub-IFD.
+type GPSError struct{ cause error }
+
+func (e GPSError) Error() string { return fmt.Sprintf("exif: loading GPS sub-IFD: %v", e.cause) }
+func (e GPSError) Unwrap() error { return e.cause }
+
+// InteropError wraps a failure loading the Interoperability sub-IFD.
+type InteropError struct{ cause error }
+
+func (e InteropError) Error() string {
+	return fmt.Sprintf("exif: loading Interoperability sub-IFD: %v", e.cause)
+}
+func (e InteropError) Unwrap() error { return e.cause }
+
+// MakerNoteError wraps a failure (including a recovered panic) returned
+// by a registered maker-note Parser (e.g. from the mknote package).
+// Parser identifies which one (its %T, since Parser implementations are
+// typically unexported single-method types with no name of their own),
+// and Offset is the MakerNote tag's offset within the file when known,
+// for batch pipelines that want to log which files had partial decodes.
+type MakerNoteError struct {
+	Parser string
+	Offset int64
+	cause  error
+}
+
+func (e MakerNoteError) Error() string {
+	if e.Offset > 0 {
+		return fmt.Sprintf("exif: %s parser failed at offset %d: %v", e.Parser, e.Offset, e.cause)
+	}
+	return fmt.Sprintf("exif: %s parser failed: %v", e.Parser, e.cause)
+}
+func (e MakerNoteError) Unwrap() error { return e.cause }
+
+// ShortReadTagValueError wraps tiff.ErrShortReadTagValue, indicating a tag
+// claimed more data than was actually present.
+type ShortReadTagValueError struct{ cause error }
+
+func (e ShortReadTagValueError) Error() string {
+	return fmt.Sprintf("exif: short read of tag value: %v", e.cause)
+}
+func (e ShortReadTagValueError) Unwrap() error { return e.cause }
+
+// DecodeError accumulates every recoverable per-tag and per-IFD failure
+// encountered while decoding, instead of any one of them aborting the
+// decode outright. A non-nil *DecodeError returned alongside a non-nil
+// *Exif means the Exif is usable but incomplete: some sub-IFD or
+// maker-note failed to parse, but whatever else loaded is present in
+// x.Fields as normal. Callers that need to tell that apart from a wholly
+// unreadable stream should check IsCriticalError(err) first.
+type DecodeError struct {
+	errs []error
+}
+
+func (de *DecodeError) Error() string {
+	if de == nil || len(de.errs) == 0 {
+		return "exif: decode error"
+	}
+	msgs := make([]string, len(de.errs))
+	for i, e := range de.errs {
+		msgs[i] = e.Error()
+	}
+	return "exif: " + strings.Join(msgs, "; ")
+}
+
+// Unwrap exposes every accumulated error to errors.Is/errors.As, per the
+// multi-error `Unwrap() []error` convention the errors package has
+// recognized since Go 1.20.
+func (de *DecodeError) Unwrap() []error {
+	if de == nil {
+		return nil
+	}
+	return de.errs
+}
+
+// Errors returns every error accumulated in de, in the order encountered.
+func (de *DecodeError) Errors() []error {
+	if de == nil {
+		return nil
+	}
+	return de.errs
+}
+
+// add appends err to de (allocating de if it was nil) and returns the
+// result; it returns de unchanged if err is nil, so callers can write
+// `errs = errs.add(err)` unconditionally in a loop.
+func (de *DecodeError) add(err error) *DecodeError {
+	if err == nil {
+		return de
+	}
+	if de == nil {
+		de = &DecodeError{}
+	}
+	de.errs = append(de.errs, err)
+	return de
+}
+
+// DecodeWarning is one registered Parser's failure, including a
+// recovered panic, reported as a structured diagnostic instead of
+// folded into Decode's returned error. See DecodeWithWarnings.
+type DecodeWarning struct {
+	Parser string
+	Offset int64
+	Err    error
+}
+
+func (w DecodeWarning) Error() string {
+	if w.Offset > 0 {
+		return fmt.Sprintf("exif: %s parser at offset %d: %v", w.Parser, w.Offset, w.Err)
+	}
+	return fmt.Sprintf("exif: %s parser: %v", w.Parser, w.Err)
+}
+
+func (w DecodeWarning) Unwrap() error { return w.Err }
+
+// DecodeWarnings accumulates every DecodeWarning encountered while
+// running registered Parsers against one Exif, in encounter order, so a
+// batch pipeline can log which files had partial decodes instead of
+// treating one bad maker note as a failed decode of the whole file.
+type DecodeWarnings []DecodeWarning
+
+func (w DecodeWarnings) Error() string {
+	if len(w) == 0 {
+		return "exif: no decode warnings"
+	}
+	msgs := make([]string, len(w))
+	for i, e := range w {
+		msgs[i] = e.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
 // IsShortReadTagValueError identifies a ErrShortReadTagValue error.
 func IsShortReadTagValueError(err error) bool {
+	var se ShortReadTagValueError
+	if errors.As(err, &se) {
+		return true
+	}
 	de, ok := err.(decodeError)
 	if ok {
 		return de.cause == tiff.ErrShortReadTagValue
@@ -47,7 +203,10 @@ func IsTagNotPresentError(err error) bool {
 	return ok
 }
 
-type tiffErrors map[tiffError]string
+// tiffErrors carries one classified error per sub-IFD stage that failed
+// during parsing, keeping the underlying error (not just its message) so
+// callers can errors.Is/errors.As through it.
+type tiffErrors map[tiffError]error
 type tiffError int
 
 func (te tiffErrors) Error() string {
@@ -59,39 +218,73 @@ func (te tiffErrors) Error() string {
 }
 
 // IsCriticalError - given the error returned by Decode, reports whether the
-// returned *Exif may contain usable information.
+// returned *Exif may contain usable information. A *DecodeError is never
+// critical by construction: Decode only builds one once it already has a
+// usable *Exif in hand and is accumulating non-fatal per-IFD/maker-note
+// failures alongside it.
 func IsCriticalError(err error) bool {
+	var ce CriticalError
+	if errors.As(err, &ce) {
+		return true
+	}
+	if _, ok := err.(*DecodeError); ok {
+		return false
+	}
 	_, ok := err.(tiffErrors)
 	return !ok
 }
 
 // IsExifError reports whether the error happened while decoding the EXIF
-// sub-IFD.
+// sub-IFD. err may be a raw tiffErrors (as parser.Parse returns it) or a
+// *DecodeError accumulating one (as Decode's entry points return it);
+// errors.As finds a tiffErrors either way.
 func IsExifError(err error) bool {
-	if te, ok := err.(tiffErrors); ok {
-		_, isExif := te[loadExif]
-		return isExif
+	var te tiffErrors
+	if errors.As(err, &te) {
+		if _, isExif := te[loadExif]; isExif {
+			return true
+		}
 	}
-	return false
+	var ee ExifError
+	return errors.As(err, &ee)
 }
 
 // IsGPSError reports whether the error happened while decoding the GPS sub-IFD.
 func IsGPSError(err error) bool {
-	if te, ok := err.(tiffErrors); ok {
-		_, isGPS := te[loadGPS]
-		return isGPS
+	var te tiffErrors
+	if errors.As(err, &te) {
+		if _, isGPS := te[loadGPS]; isGPS {
+			return true
+		}
 	}
-	return false
+	var ge GPSError
+	return errors.As(err, &ge)
 }
 
 // IsInteroperabilityError reports whether the error happened while decoding the
 // Interoperability sub-IFD.
 func IsInteroperabilityError(err error) bool {
-	if te, ok := err.(tiffErrors); ok {
-		_, isInterop := te[loadInteroperability]
-		return isInterop
+	var te tiffErrors
+	if errors.As(err, &te) {
+		if _, isInterop := te[loadInteroperability]; isInterop {
+			return true
+		}
 	}
-	return false
+	var ie InteropError
+	return errors.As(err, &ie)
+}
+
+// IsInteropError is an alias of IsInteroperabilityError.
+func IsInteropError(err error) bool {
+	return IsInteroperabilityError(err)
+}
+
+// IsMakerNoteError reports whether the error came from a registered
+// maker-note Parser (e.g. one in the mknote package) rather than from the
+// core EXIF/GPS/Interop decoding path.
+func IsMakerNoteError(err error) bool {
+	var me MakerNoteError
+	return errors.As(err, &me)
 }
 
 var stagePrefix = map[tiffError]string{
@@ -11,11 +11,14 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
 	heif "github.com/jdeng/goheif"
+	"github.com/rpajarola/exiftools/container"
 	"github.com/rpajarola/exiftools/models"
 	"github.com/rpajarola/exiftools/tiff"
 )
@@ -24,6 +27,24 @@ import (
 type DecodeOptions struct {
 	KeepUnknownTags bool // Keep unknown tags (default: false)
 	MaxExifSize     int  // maximum size of exif data (default: 4MB)
+
+	// IncludeFields, if non-empty, restricts loaded fields to those whose
+	// FieldName matches at least one of these regexp patterns. Invalid
+	// patterns are ignored.
+	IncludeFields []string
+	// ExcludeFields drops any field whose FieldName matches one of these
+	// regexp patterns, even if it also matches IncludeFields. Invalid
+	// patterns are ignored.
+	ExcludeFields []string
+	// SkipMakerNote disables maker-note parsing (the mknote package
+	// parsers check Exif.SkipMakerNote and return early).
+	SkipMakerNote bool
+	// SkipThumbnail skips loading IFD1 (thumbnail) fields.
+	SkipThumbnail bool
+	// MaxTagValueBytes, if non-zero, drops any tag whose value is larger
+	// than this many bytes, to defend against malformed files claiming
+	// huge counts.
+	MaxTagValueBytes int
 }
 
 const (
@@ -37,10 +58,13 @@ const (
 
 // Exif provides access to decoded EXIF metadata fields and values.
 type Exif struct {
-	Tiff *tiff.Tiff
+	Tiff   *tiff.Tiff
 	Fields map[models.FieldName]*tiff.Tag
-	Raw  []byte
-	opts DecodeOptions
+	Raw    []byte
+	opts   DecodeOptions
+
+	includeRe []*regexp.Regexp
+	excludeRe []*regexp.Regexp
 }
 
 func New(tif *tiff.Tiff, raw []byte, opts *DecodeOptions) *Exif {
@@ -48,11 +72,50 @@ func New(tif *tiff.Tiff, raw []byte, opts *DecodeOptions) *Exif {
 		opts = &DecodeOptions{}
 	}
 	return &Exif{
-		Fields: map[models.FieldName]*tiff.Tag{},
-		Tiff: tif,
-		Raw:  raw,
-		opts: *opts,
+		Fields:    map[models.FieldName]*tiff.Tag{},
+		Tiff:      tif,
+		Raw:       raw,
+		opts:      *opts,
+		includeRe: compilePatterns(opts.IncludeFields),
+		excludeRe: compilePatterns(opts.ExcludeFields),
+	}
+}
+
+// compilePatterns compiles each regexp pattern, silently dropping any that
+// fail to compile.
+func compilePatterns(patterns []string) []*regexp.Regexp {
+	var res []*regexp.Regexp
+	for _, p := range patterns {
+		if re, err := regexp.Compile(p); err == nil {
+			res = append(res, re)
+		}
+	}
+	return res
+}
+
+// SkipMakerNote reports whether maker-note parsers (in the mknote package)
+// should skip this Exif, per DecodeOptions.SkipMakerNote.
+func (x *Exif) SkipMakerNote() bool {
+	return x.opts.SkipMakerNote
+}
+
+// fieldAllowed reports whether name passes the configured
+// IncludeFields/ExcludeFields filters.
+func (x *Exif) fieldAllowed(name models.FieldName) bool {
+	for _, re := range x.excludeRe {
+		if re.MatchString(string(name)) {
+			return false
+		}
+	}
+	if len(x.includeRe) == 0 {
+		return true
+	}
+	for _, re := range x.includeRe {
+		if re.MatchString(string(name)) {
+			return true
+		}
 	}
+	return false
 }
 
 // Decode parses EXIF data from r (a TIFF, JPEG, or raw EXIF block)
@@ -67,6 +130,40 @@ func Decode(r io.Reader) (*Exif, error) {
 	return DecodeWithOptions(r, &DecodeOptions{KeepUnknownTags: false})
 }
 
+// DecodeWithWarnings is Decode, but reports each registered Parser's
+// failure (including a recovered panic) as a DecodeWarning instead of
+// folding it into a *DecodeError. err is non-nil only when the
+// container/TIFF structure itself couldn't be decoded at all (see
+// IsCriticalError); a malformed maker note never prevents DecodeWithWarnings
+// from returning a usable *Exif, only adds a warning describing it.
+func DecodeWithWarnings(r io.Reader) (*Exif, DecodeWarnings, error) {
+	x, err := Decode(r)
+	if err != nil && IsCriticalError(err) {
+		return x, nil, err
+	}
+
+	var warnings DecodeWarnings
+	var de *DecodeError
+	if errors.As(err, &de) {
+		for _, e := range de.Errors() {
+			var me MakerNoteError
+			if errors.As(e, &me) {
+				warnings = append(warnings, DecodeWarning{Parser: me.Parser, Offset: me.Offset, Err: me.cause})
+				continue
+			}
+			warnings = append(warnings, DecodeWarning{Parser: "exif", Err: e})
+		}
+	}
+	return x, warnings, nil
+}
+
+// MOV/XMP-specific fields synthesized from QuickTime/MP4 boxes that have
+// no TIFF tag ID of their own.
+var (
+	XMPPacket  models.FieldName = "XMPPacket"
+	MOVGPSData models.FieldName = "MOV.GPSData"
+)
+
 // fileType represents the detected file format
 type fileType int
 
@@ -74,11 +171,44 @@ const (
 	fileTypeTIFF fileType = iota
 	fileTypeRawExif
 	fileTypeHEIF
+	fileTypeAVIF
+	fileTypeMOV
 	fileTypeJPEG
+	fileTypeContainer
 )
 
-// detectFileType examines the header to determine the file format
+// movBrands lists the ISO-BMFF major/compatible brands used by QuickTime
+// and MP4/M4A/3GP movie files, as opposed to still-image brands such as
+// HEIF/HEIC/AVIF.
+var movBrands = map[string]bool{
+	"qt  ": true,
+	"isom": true,
+	"iso2": true,
+	"mp41": true,
+	"mp42": true,
+	"M4A ": true,
+	"M4V ": true,
+	"3gp4": true,
+	"3gp5": true,
+	"3g2a": true,
+}
+
+// avifBrands lists the ISO-BMFF brands identifying an AVIF still image or
+// image sequence, which this package extracts EXIF from via its own
+// ISO-BMFF walker rather than goheif (which only understands HEIF/HEIC).
+var avifBrands = map[string]bool{
+	"avif": true,
+	"avis": true,
+}
+
+// detectFileType examines the header to determine the file format. header
+// may be shorter than the usual 16 bytes for small/truncated inputs; any
+// signature it doesn't fully contain is treated as a non-match rather than
+// a panic, falling back to fileTypeJPEG.
 func detectFileType(header []byte) fileType {
+	if len(header) < 4 {
+		return fileTypeJPEG
+	}
 	switch string(header[0:4]) {
 	case "II*\x00", "MM\x00*":
 		// TIFF - Little/Big endian
@@ -86,14 +216,45 @@ func detectFileType(header []byte) fileType {
 	case "Exif":
 		return fileTypeRawExif
 	default:
-		if string(header[4:]) == "ftyp" {
-			return fileTypeHEIF
+		if len(header) >= 12 && string(header[4:8]) == "ftyp" {
+			brand := string(header[8:12])
+			switch {
+			case avifBrands[brand]:
+				return fileTypeAVIF
+			case movBrands[brand]:
+				return fileTypeMOV
+			default:
+				return fileTypeHEIF
+			}
+		}
+		if _, err := sniffContainer(header); err == nil {
+			return fileTypeContainer
 		}
 		// Assume JPEG
 		return fileTypeJPEG
 	}
 }
 
+// processContainerFile extracts EXIF data via a registered ContainerParser
+// (e.g. PNG's eXIf chunk or WebP's EXIF chunk) and decodes it as TIFF.
+func processContainerFile(r io.Reader, header []byte) (*bytes.Reader, *tiff.Tiff, error) {
+	c, err := sniffContainer(header)
+	if err != nil {
+		return nil, nil, err
+	}
+	raw, _, err := c.ParseContainer(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("exif: %s: %w", c.Name(), err)
+	}
+	er := bytes.NewReader(raw)
+	tif, err := tiff.Decode(er)
+	if err != nil {
+		return nil, nil, err
+	}
+	er.Seek(0, 0)
+	return er, tif, nil
+}
+
 // processHEIFFile extracts EXIF data from HEIF/HEIC files
 func processHEIFFile(r io.Reader) (io.Reader, error) {
 	// For HEIF files, we need a ReaderAt interface
@@ -120,6 +281,108 @@ func processHEIFFile(r io.Reader) (io.Reader, error) {
 	}
 }
 
+// processAVIFFile extracts EXIF data from an AVIF file's ISO-BMFF "meta"
+// box by walking its "iinf"/"iloc" children for the "Exif" item, rather
+// than delegating to goheif (which only recognizes HEIF/HEIC brands).
+func processAVIFFile(r io.Reader) (io.Reader, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("exif: unable to read avif file: %w", err)
+	}
+	boxes, err := readISOBMFFBoxes(data)
+	if err != nil {
+		return nil, fmt.Errorf("exif: avif: %w", err)
+	}
+	meta, ok := findISOBMFFBox(boxes, "meta")
+	if !ok {
+		return nil, fmt.Errorf("exif: avif: no meta box found")
+	}
+	offset, length, err := isobmffExifItemLocation(meta.payload)
+	if err != nil {
+		return nil, fmt.Errorf("exif: avif: %w", err)
+	}
+	if offset < 0 || offset+length > len(data) {
+		return nil, fmt.Errorf("exif: avif: Exif item location out of range")
+	}
+	item := data[offset : offset+length]
+
+	// Per ISO/IEC 23008-12, an Exif item's payload begins with a 4-byte
+	// big-endian "exif_tiff_header_offset" giving the number of bytes
+	// (typically the ASCII "Exif\x00\x00" prefix) to skip before the
+	// actual TIFF header.
+	if len(item) < 4 {
+		return nil, fmt.Errorf("exif: avif: Exif item too short")
+	}
+	tiffOffset := int(binary.BigEndian.Uint32(item[0:4]))
+	if 4+tiffOffset > len(item) {
+		return nil, fmt.Errorf("exif: avif: Exif item tiff header offset out of range")
+	}
+	return bytes.NewReader(item[4+tiffOffset:]), nil
+}
+
+// movXMPUUID is the well-known UUID (per the XMP specification) used to
+// identify a QuickTime/MP4 "uuid" box carrying an embedded XMP packet.
+var movXMPUUID = []byte{0xBE, 0x7A, 0xCF, 0xCB, 0x97, 0xA9, 0x42, 0xE8, 0x9C, 0x71, 0x99, 0x94, 0x91, 0xE3, 0xAF, 0xAC}
+
+// processMOVFile walks a QuickTime/MP4 file's top-level boxes looking for
+// an embedded XMP packet (a "uuid" box tagged with movXMPUUID) and for the
+// "moov"/"udta" ©day creation-date atom, synthesizing models.FieldName
+// entries for whatever it finds. MOV files carry no TIFF/EXIF structure,
+// so unlike the other file types this returns a ready-to-use Exif rather
+// than a TIFF byte stream.
+func processMOVFile(r io.Reader, opts *DecodeOptions) (*Exif, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("exif: unable to read mov file: %w", err)
+	}
+	boxes, err := readISOBMFFBoxes(data)
+	if err != nil {
+		return nil, fmt.Errorf("exif: mov: %w", err)
+	}
+
+	x := New(nil, data, opts)
+	for _, b := range boxes {
+		switch b.typ {
+		case "uuid":
+			if len(b.payload) >= 16 && bytes.Equal(b.payload[:16], movXMPUUID) {
+				x.Set(XMPPacket, tiff.MakeTag(0, tiff.DTUndefined, uint32(len(b.payload)-16), binary.BigEndian, b.payload[16:]))
+			}
+		case "moov":
+			loadMOVUdtaFields(x, b.payload)
+		}
+	}
+	return x, nil
+}
+
+// loadMOVUdtaFields walks a "moov" box's children for "udta", extracting
+// the QuickTime "©day" (creation date) and "gps " atoms if present.
+func loadMOVUdtaFields(x *Exif, moovPayload []byte) {
+	children, err := readISOBMFFBoxes(moovPayload)
+	if err != nil {
+		return
+	}
+	udta, ok := findISOBMFFBox(children, "udta")
+	if !ok {
+		return
+	}
+	entries, err := readISOBMFFBoxes(udta.payload)
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		switch e.typ {
+		case "\xa9day":
+			// QuickTime string atoms are prefixed with a 2-byte length
+			// and a 2-byte language code.
+			if len(e.payload) > 4 {
+				x.Set(models.DateTimeOriginal, tiff.MakeTag(0, tiff.DTAscii, uint32(len(e.payload)-4), binary.BigEndian, e.payload[4:]))
+			}
+		case "gps ":
+			x.Set(MOVGPSData, tiff.MakeTag(0, tiff.DTUndefined, uint32(len(e.payload)), binary.BigEndian, e.payload))
+		}
+	}
+}
+
 // processRawExifFile validates and processes raw EXIF data
 func processRawExifFile(r io.Reader) error {
 	var header [6]byte
@@ -174,12 +437,18 @@ func DecodeWithOptions(r io.Reader, opts *DecodeOptions) (*Exif, error) {
 		opts.MaxExifSize = exifLengthCutoff
 	}
 
-	// Read header to detect file type
-	header := make([]byte, 8)
+	// Read header to detect file type. 16 bytes covers the longest
+	// signature any registered ContainerParser needs to sniff (WebP's
+	// 12-byte "RIFF....WEBP"). Inputs shorter than that (or empty) are
+	// not an error here: detectFileType degrades to its JPEG/unknown
+	// fallback on a short header, and the chosen processXXXFile will
+	// fail with a more specific error once it actually tries to parse.
+	header := make([]byte, 16)
 	n, err := io.ReadFull(r, header)
-	if err != nil {
-		return nil, fmt.Errorf("exif: error reading 8 byte header, got %d, %v", n, err)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, fmt.Errorf("exif: error reading header: %v", err)
 	}
+	header = header[:n]
 
 	// Detect the file type
 	fType := detectFileType(header)
@@ -192,8 +461,26 @@ func DecodeWithOptions(r io.Reader, opts *DecodeOptions) (*Exif, error) {
 		tif *tiff.Tiff
 	)
 
+	// MOV/MP4 files carry no TIFF/EXIF structure; processMOVFile builds
+	// and returns a ready-to-use Exif directly instead of a TIFF stream,
+	// so it bypasses the rest of this function (including the parser
+	// registry, which assumes a decoded x.Tiff).
+	if fType == fileTypeMOV {
+		x, err := processMOVFile(r, opts)
+		if err != nil {
+			return nil, decodeError{cause: err}
+		}
+		return x, nil
+	}
+
 	// Process based on file type
 	switch fType {
+	case fileTypeAVIF:
+		r, err = processAVIFFile(r)
+		if err != nil {
+			return nil, err
+		}
+		er, tif, err = processTIFFFile(r)
 	case fileTypeHEIF:
 		r, err = processHEIFFile(r)
 		if err != nil {
@@ -211,6 +498,8 @@ func DecodeWithOptions(r io.Reader, opts *DecodeOptions) (*Exif, error) {
 		er, tif, err = processTIFFFile(r)
 	case fileTypeJPEG:
 		er, tif, err = processJPEGFile(r)
+	case fileTypeContainer:
+		er, tif, err = processContainerFile(r, header)
 	}
 
 	if err != nil {
@@ -228,17 +517,9 @@ func DecodeWithOptions(r io.Reader, opts *DecodeOptions) (*Exif, error) {
 	x := New(tif, raw, opts)
 
 	// Run parsers
-	for i, p := range parsers {
-		if err := p.Parse(x); err != nil {
-			if _, ok := err.(tiffErrors); ok {
-				return x, err
-			}
-			// This should never happen, as Parse always returns a tiffError
-			// for now, but that could change.
-			return x, fmt.Errorf("exif: parser %v failed (%v)", i, err)
-		}
+	if errs := runParsers(x); errs != nil {
+		return x, errs
 	}
-
 	return x, nil
 }
 
@@ -256,6 +537,12 @@ func (x *Exif) LoadTags(d *tiff.Dir, fieldMap map[uint16]models.FieldName, showM
 			}
 			name = models.FieldName(fmt.Sprintf("%v%x", models.UnknownPrefix, tag.Id))
 		}
+		if !x.fieldAllowed(name) {
+			continue
+		}
+		if max := x.opts.MaxTagValueBytes; max > 0 && len(tag.Val) > max {
+			continue
+		}
 		x.Fields[name] = tag
 	}
 }
@@ -351,6 +638,18 @@ func (x *Exif) DateTime(fields ...models.FieldName) (time.Time, error) {
 	//return time.ParseInLocation(exifTimeLayout, dateStr, timeZone)
 }
 
+// FileTime returns the modification time of the file at path. It is a
+// convenience fallback for callers that want a best-effort timestamp when
+// a photo has no usable DateTime/DateTimeOriginal field, or when neither
+// TimeZone nor GPSTimeZone can resolve an offset for it.
+func FileTime(path string) (time.Time, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return fi.ModTime(), nil
+}
+
 // TimeZone -
 func (x *Exif) TimeZone() (*time.Location, error) {
 	// TODO: parse more timezone fields (e.g. Nikon WorldTime).
@@ -368,6 +667,28 @@ func (x *Exif) TimeZone() (*time.Location, error) {
 	return time.FixedZone("", offsetMinutes*60), nil
 }
 
+// GPSTimeZone estimates the photo's UTC offset by comparing the EXIF
+// DateTime[Original] (recorded in the camera's local time, with no zone
+// info) against the GPS-derived UTC timestamp (GPSDateStamp/GPSTimeStamp),
+// rounding to the nearest 15 minutes, the resolution real-world timezone
+// offsets use. It returns an error if either field is missing or
+// unparseable.
+func (x *Exif) GPSTimeZone() (*time.Location, error) {
+	local, err := x.DateTime()
+	if err != nil {
+		return nil, fmt.Errorf("exif: GPSTimeZone: %w", err)
+	}
+	gpsUTC, err := x.GPSTimeStamp()
+	if err != nil {
+		return nil, fmt.Errorf("exif: GPSTimeZone: %w", err)
+	}
+
+	const step = 15 * time.Minute
+	offset := local.Sub(gpsUTC)
+	rounded := time.Duration(math.Round(float64(offset)/float64(step))) * step
+	return time.FixedZone("", int(rounded.Seconds())), nil
+}
+
 func ratFloat(num, dem int64) float64 {
 	return float64(num) / float64(dem)
 }
@@ -661,6 +982,22 @@ func DecodeWithParseHeaderAndOptions(r io.Reader, opts *DecodeOptions) (x *Exif,
 		return nil, fmt.Errorf("failed to read EXIF data: %w", err)
 	}
 
+	// Try the container package first: it knows how to locate the EXIF
+	// payload in PNG/WebP/HEIF/JPEG/TIFF properly instead of scanning for
+	// a bare TIFF/"Exif\x00\x00" signature at an arbitrary offset. Fall
+	// back to that scan (below) for inputs container doesn't recognize,
+	// e.g. a raw EXIF blob with leading garbage bytes.
+	if raw, _, cErr := container.ExtractRawEXIF(bytes.NewReader(data)); cErr == nil {
+		tif, tErr := tiff.Decode(bytes.NewReader(raw))
+		if tErr == nil {
+			x = New(tif, raw, opts)
+			if errs := runParsers(x); errs != nil {
+				return x, errs
+			}
+			return x, nil
+		}
+	}
+
 	foundAt := -1
 	for i := 0; i < len(data); i++ {
 		if err = checkExifHeader(data[i:]); err == nil {
@@ -684,17 +1021,9 @@ func DecodeWithParseHeaderAndOptions(r io.Reader, opts *DecodeOptions) (x *Exif,
 	// build an exif structure from the tiff
 	x = New(tif, raw, opts)
 
-	for i, p := range parsers {
-		if err := p.Parse(x); err != nil {
-			if _, ok := err.(tiffErrors); ok {
-				return x, err
-			}
-			// This should never happen, as Parse always returns a tiffError
-			// for now, but that could change.
-			return x, fmt.Errorf("exif: parser %v failed (%v)", i, err)
-		}
+	if errs := runParsers(x); errs != nil {
+		return x, errs
 	}
-
 	return x, nil
 }
 
@@ -0,0 +1,82 @@
+// Package exiftool provides an alternative exif.Parser/Decoder backed by
+// Phil Harvey's exiftool binary (via github.com/barasher/go-exiftool)
+// instead of this module's pure-Go TIFF decoder. It returns the same
+// *exif.Exif struct the native decoder does, so callers using x.Get,
+// x.GetAperture, x.LatLong, etc. don't need to change.
+//
+// The tradeoff is the opposite of the native decoder's: exiftool
+// recognizes hundreds of maker-note tags and non-standard formats this
+// module's decoder can't, at the cost of shelling out to an external
+// process that must be installed separately.
+package exiftool
+
+import (
+	"fmt"
+	"os/exec"
+
+	goexiftool "github.com/barasher/go-exiftool"
+	"github.com/rpajarola/exiftools/exif"
+	"github.com/rpajarola/exiftools/models"
+)
+
+// Decoder wraps a long-lived exiftool process. Callers should Close it
+// once done to let the process exit.
+type Decoder struct {
+	et *goexiftool.Exiftool
+}
+
+// NewDecoder starts (or attaches to) the exiftool binary and returns a
+// Decoder ready to process files. It returns an error if the exiftool
+// binary cannot be found on PATH, so callers can fall back to the native
+// decoder instead of failing outright.
+func NewDecoder(opts ...func(*goexiftool.Exiftool) error) (*Decoder, error) {
+	if _, err := exec.LookPath("exiftool"); err != nil {
+		return nil, fmt.Errorf("exiftool: binary not found on PATH: %w", err)
+	}
+	et, err := goexiftool.NewExiftool(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("exiftool: starting process: %w", err)
+	}
+	return &Decoder{et: et}, nil
+}
+
+// Close terminates the underlying exiftool process.
+func (d *Decoder) Close() error {
+	return d.et.Close()
+}
+
+// DecodeFile runs exiftool against a single file and returns its metadata
+// as an *exif.Exif.
+func (d *Decoder) DecodeFile(path string) (*exif.Exif, error) {
+	xs, err := d.DecodeFiles([]string{path})
+	if err != nil {
+		return nil, err
+	}
+	return xs[0], nil
+}
+
+// DecodeFiles runs exiftool against every path in one batched call,
+// reusing the same exiftool stdin session for all of them. It returns one
+// *exif.Exif per input path, in the same order; a file that exiftool
+// failed to read gets a non-nil *exif.Exif with no fields and a
+// corresponding error recorded in the returned slice's error.
+func (d *Decoder) DecodeFiles(paths []string) ([]*exif.Exif, error) {
+	results := d.et.ExtractMetadata(paths...)
+	xs := make([]*exif.Exif, len(results))
+	for i, res := range results {
+		x := exif.New(nil, nil, nil)
+		if res.Err != nil {
+			xs[i] = x
+			continue
+		}
+		for tagName, val := range res.Fields {
+			name, ok := fieldNames[tagName]
+			if !ok {
+				name = models.FieldName(tagName)
+			}
+			x.Set(name, makeTag(val))
+		}
+		xs[i] = x
+	}
+	return xs, nil
+}
@@ -0,0 +1,40 @@
+package exiftool
+
+import "github.com/rpajarola/exiftools/models"
+
+// fieldNames maps exiftool's own tag name strings (as printed in its -j
+// JSON output) to this module's models.FieldName constants, so that
+// x.Get(models.Model) etc. works identically regardless of which decoder
+// produced the *exif.Exif. Tags with no corresponding constant fall back
+// to their exiftool name verbatim (see DecodeFiles).
+var fieldNames = map[string]models.FieldName{
+	"Make":               models.Make,
+	"Model":              models.Model,
+	"Orientation":        models.OrientationTag,
+	"DateTime":           models.DateTime,
+	"DateTimeOriginal":   models.DateTimeOriginal,
+	"SubSecTimeOriginal": models.SubSecTimeOriginal,
+	"ExposureTime":       models.ExposureTime,
+	"FNumber":            models.FNumber,
+	"ExposureProgram":    models.ExposureProgram,
+	"ISO":                models.ISOSpeedRatings,
+	"ISOSpeedRatings":    models.ISOSpeedRatings,
+	"ExposureBiasValue":  models.ExposureBiasValue,
+	"MeteringMode":       models.MeteringModeTag,
+	"Flash":              models.Flash,
+	"FocalLength":        models.FocalLength,
+	"ExposureMode":       models.ExposureModeTag,
+	"ImageWidth":         models.ImageWidth,
+	"ImageHeight":        models.ImageLength,
+	"PixelXDimension":    models.PixelXDimension,
+	"PixelYDimension":    models.PixelYDimension,
+	"MakerNote":          models.MakerNote,
+	"GPSAltitude":        models.GPSAltitude,
+	"GPSAltitudeRef":     models.GPSAltitudeRef,
+	"GPSTimeStamp":       models.GPSTimeStamp,
+	"GPSDateStamp":       models.GPSDateStamp,
+	"ThumbnailOffset":    models.ThumbJPEGInterchangeFormat,
+	"ThumbnailLength":    models.ThumbJPEGInterchangeFormatLength,
+	"PreviewImageStart":  models.PreviewImageStart,
+	"PreviewImageLength": models.PreviewImageLength,
+}
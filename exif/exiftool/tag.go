@@ -0,0 +1,50 @@
+package exiftool
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/rpajarola/exiftools/tiff"
+)
+
+// makeTag synthesizes a tiff.Tag from one exiftool field value. exiftool's
+// own JSON output is already type-decoded (string, float64, bool, or a
+// []interface{} for list-valued tags), so this recovers more fidelity than
+// UnmarshalExiftoolJSON's always-Ascii fallback, but still can't recover
+// the original EXIF type (short vs. long, rational numerator/denominator)
+// since that information doesn't survive exiftool's own decoding either.
+func makeTag(val interface{}) *tiff.Tag {
+	switch v := val.(type) {
+	case float64:
+		if v == float64(int64(v)) {
+			return tiff.MakeTag(0, tiff.DTLong, 1, binary.BigEndian, encodeLong(int64(v)))
+		}
+		return tiff.MakeTag(0, tiff.DTRational, 1, binary.BigEndian, encodeRational(v))
+	case bool:
+		n := int64(0)
+		if v {
+			n = 1
+		}
+		return tiff.MakeTag(0, tiff.DTLong, 1, binary.BigEndian, encodeLong(n))
+	default:
+		raw := append([]byte(fmt.Sprint(v)), 0)
+		return tiff.MakeTag(0, tiff.DTAscii, uint32(len(raw)), binary.BigEndian, raw)
+	}
+}
+
+func encodeLong(v int64) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, uint32(v))
+	return b
+}
+
+// encodeRational approximates a float64 as a rational with a fixed
+// denominator, the same scheme tiff readers commonly expect for EXIF
+// rational tags (e.g. F-number, exposure time).
+func encodeRational(v float64) []byte {
+	const denom = 1000000
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint32(b[0:4], uint32(v*denom))
+	binary.BigEndian.PutUint32(b[4:8], denom)
+	return b
+}
@@ -0,0 +1,21 @@
+package exif
+
+import "github.com/rpajarola/exiftools/models"
+
+// FieldName re-exports models.FieldName: the maker-note parsers in mknote
+// and callers that only need the handful of common fields below shouldn't
+// have to import models directly just to name them.
+type FieldName = models.FieldName
+
+// Common fields re-exported from models for convenience.
+const (
+	Make             = models.Make
+	Model            = models.Model
+	DateTime         = models.DateTime
+	DateTimeOriginal = models.DateTimeOriginal
+	MakerNote        = models.MakerNote
+	FocalLength      = models.FocalLength
+	ColorSpace       = models.ColorSpace
+	GPSAltitude      = models.GPSAltitude
+	GPSTimeStamp     = models.GPSTimeStamp
+)
@@ -0,0 +1,234 @@
+package exif
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// isobmffBox is one parsed ISO Base Media File Format box: a 4-byte size,
+// a 4-byte fourCC type, and everything after that (i.e. not including a
+// 64-bit "largesize" extension, which is consumed when present).
+type isobmffBox struct {
+	typ     string
+	payload []byte
+}
+
+// readISOBMFFBoxes walks sibling boxes in data until it is exhausted.
+func readISOBMFFBoxes(data []byte) ([]isobmffBox, error) {
+	var boxes []isobmffBox
+	i := 0
+	for i+8 <= len(data) {
+		size := int(binary.BigEndian.Uint32(data[i : i+4]))
+		typ := string(data[i+4 : i+8])
+		hdr := 8
+
+		switch size {
+		case 1: // 64-bit "largesize" follows the type
+			if i+16 > len(data) {
+				return nil, fmt.Errorf("exif: isobmff: truncated largesize box %q", typ)
+			}
+			size = int(binary.BigEndian.Uint64(data[i+8 : i+16]))
+			hdr = 16
+		case 0: // box extends to the end of its parent
+			size = len(data) - i
+		}
+
+		if size < hdr || i+size > len(data) {
+			return nil, fmt.Errorf("exif: isobmff: invalid size for box %q", typ)
+		}
+		boxes = append(boxes, isobmffBox{typ: typ, payload: data[i+hdr : i+size]})
+		i += size
+	}
+	return boxes, nil
+}
+
+// findISOBMFFBox returns the first sibling box of the given type.
+func findISOBMFFBox(boxes []isobmffBox, typ string) (isobmffBox, bool) {
+	for _, b := range boxes {
+		if b.typ == typ {
+			return b, true
+		}
+	}
+	return isobmffBox{}, false
+}
+
+// isobmffExifItemLocation walks a "meta" box's "iinf"/"iloc" children (the
+// common, non-extent-indexed case: iinf/infe/iloc version 0) to find the
+// byte range of the item whose type is "Exif".
+func isobmffExifItemLocation(metaPayload []byte) (offset, length int, err error) {
+	// The meta box itself has a 4-byte full-box header (version+flags)
+	// before its children.
+	if len(metaPayload) < 4 {
+		return 0, 0, fmt.Errorf("exif: isobmff: meta box too short")
+	}
+	children, err := readISOBMFFBoxes(metaPayload[4:])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	iinf, ok := findISOBMFFBox(children, "iinf")
+	if !ok {
+		return 0, 0, fmt.Errorf("exif: isobmff: no iinf box")
+	}
+	exifItemID, err := findExifItemID(iinf.payload)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	iloc, ok := findISOBMFFBox(children, "iloc")
+	if !ok {
+		return 0, 0, fmt.Errorf("exif: isobmff: no iloc box")
+	}
+	return findItemLocation(iloc.payload, exifItemID)
+}
+
+// findExifItemID scans an iinf box's "infe" children for the item whose
+// type is "Exif" and returns its item ID.
+func findExifItemID(iinfPayload []byte) (int, error) {
+	if len(iinfPayload) < 6 {
+		return 0, fmt.Errorf("exif: isobmff: iinf box too short")
+	}
+	version := iinfPayload[0]
+	pos := 4 // version + flags
+	if version == 0 {
+		pos += 2 // entry_count (uint16)
+	} else {
+		pos += 4 // entry_count (uint32)
+	}
+	if pos > len(iinfPayload) {
+		return 0, fmt.Errorf("exif: isobmff: iinf box too short")
+	}
+
+	entries, err := readISOBMFFBoxes(iinfPayload[pos:])
+	if err != nil {
+		return 0, err
+	}
+	for _, e := range entries {
+		if e.typ != "infe" {
+			continue
+		}
+		id, itemType, err := parseInfe(e.payload)
+		if err != nil {
+			continue
+		}
+		if itemType == "Exif" {
+			return id, nil
+		}
+	}
+	return 0, fmt.Errorf("exif: isobmff: no Exif item in iinf")
+}
+
+// parseInfe parses an "infe" (item info entry) box, supporting the
+// versions actually emitted by AVIF/HEIF encoders (2 and 3).
+func parseInfe(payload []byte) (id int, itemType string, err error) {
+	if len(payload) < 4 {
+		return 0, "", fmt.Errorf("exif: isobmff: infe box too short")
+	}
+	version := payload[0]
+	pos := 4
+	switch {
+	case version == 2:
+		if pos+2+2+4 > len(payload) {
+			return 0, "", fmt.Errorf("exif: isobmff: infe(v2) too short")
+		}
+		id = int(binary.BigEndian.Uint16(payload[pos:]))
+		itemType = string(payload[pos+2+2 : pos+2+2+4])
+	case version == 3:
+		if pos+4+2+4 > len(payload) {
+			return 0, "", fmt.Errorf("exif: isobmff: infe(v3) too short")
+		}
+		id = int(binary.BigEndian.Uint32(payload[pos:]))
+		itemType = string(payload[pos+4+2 : pos+4+2+4])
+	default:
+		return 0, "", fmt.Errorf("exif: isobmff: unsupported infe version %d", version)
+	}
+	return id, itemType, nil
+}
+
+// findItemLocation parses an "iloc" box (version 0 or 1, single-extent
+// items, as written by every AVIF/HEIF encoder in practice) looking for
+// itemID, returning its (offset, length) within the file.
+func findItemLocation(payload []byte, itemID int) (offset, length int, err error) {
+	if len(payload) < 6 {
+		return 0, 0, fmt.Errorf("exif: isobmff: iloc box too short")
+	}
+	version := payload[0]
+	offsetSize := int(payload[4] >> 4)
+	lengthSize := int(payload[4] & 0x0f)
+	baseOffsetSize := int(payload[5] >> 4)
+	pos := 6
+	if version == 1 || version == 2 {
+		pos++ // index_size nibble shares the byte with base_offset_size on some encoders; skip conservatively
+	}
+
+	var itemIDSize, itemCountSize int
+	if version < 2 {
+		itemIDSize, itemCountSize = 2, 2
+	} else {
+		itemIDSize, itemCountSize = 4, 4
+	}
+
+	itemCount, err := readUintBE(payload, pos, itemCountSize)
+	if err != nil {
+		return 0, 0, err
+	}
+	pos += itemCountSize
+
+	for i := 0; i < itemCount; i++ {
+		curID, err := readUintBE(payload, pos, itemIDSize)
+		if err != nil {
+			return 0, 0, err
+		}
+		pos += itemIDSize
+		if version == 1 || version == 2 {
+			pos += 2 // construction_method
+		}
+		pos += 2 // data_reference_index
+		baseOffset, err := readUintBE(payload, pos, baseOffsetSize)
+		if err != nil {
+			return 0, 0, err
+		}
+		pos += baseOffsetSize
+		extentCount, err := readUintBE(payload, pos, 2)
+		if err != nil {
+			return 0, 0, err
+		}
+		pos += 2
+
+		var firstExtentOffset, firstExtentLength int
+		for e := 0; e < extentCount; e++ {
+			extOffset, err := readUintBE(payload, pos, offsetSize)
+			if err != nil {
+				return 0, 0, err
+			}
+			pos += offsetSize
+			extLength, err := readUintBE(payload, pos, lengthSize)
+			if err != nil {
+				return 0, 0, err
+			}
+			pos += lengthSize
+			if e == 0 {
+				firstExtentOffset, firstExtentLength = extOffset, extLength
+			}
+		}
+
+		if curID == itemID {
+			return baseOffset + firstExtentOffset, firstExtentLength, nil
+		}
+	}
+	return 0, 0, fmt.Errorf("exif: isobmff: item %d not found in iloc", itemID)
+}
+
+func readUintBE(data []byte, pos, size int) (int, error) {
+	if size == 0 {
+		return 0, nil
+	}
+	if pos < 0 || pos+size > len(data) {
+		return 0, fmt.Errorf("exif: isobmff: read out of range at %d (size %d)", pos, size)
+	}
+	var v uint64
+	for _, b := range data[pos : pos+size] {
+		v = v<<8 | uint64(b)
+	}
+	return int(v), nil
+}
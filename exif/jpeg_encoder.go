@@ -0,0 +1,95 @@
+package exif
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// ReplaceExif copies src (a JPEG stream) to dst with its APP1 EXIF segment
+// replaced by ex, equivalent to ex.WriteJPEG(dst, src). It exists as a
+// free function for callers that want a `noun.Verb(src, dst, ex)`-shaped
+// helper rather than a method on Exif.
+func ReplaceExif(dst io.Writer, src io.Reader, ex *Exif) error {
+	return ex.WriteJPEG(dst, src)
+}
+
+// WriteJPEG copies src (a JPEG file, typically the one x was originally
+// decoded from) to dst, replacing its existing EXIF APP1 segment with the
+// current contents of x via EncodeJPEG. Every other segment, and the
+// compressed image data following the start-of-scan marker, is copied
+// through unchanged.
+//
+// It returns an error if src is not a JPEG, or contains no EXIF APP1
+// segment to replace.
+func (x *Exif) WriteJPEG(dst io.Writer, src io.Reader) error {
+	br := bufio.NewReader(src)
+
+	var soi [2]byte
+	if _, err := io.ReadFull(br, soi[:]); err != nil {
+		return fmt.Errorf("exif: reading SOI: %w", err)
+	}
+	if soi[0] != 0xFF || soi[1] != 0xD8 {
+		return fmt.Errorf("exif: not a JPEG file (bad SOI marker)")
+	}
+	if _, err := dst.Write(soi[:]); err != nil {
+		return err
+	}
+
+	replaced := false
+	for {
+		var marker [2]byte
+		if _, err := io.ReadFull(br, marker[:]); err != nil {
+			return fmt.Errorf("exif: reading segment marker: %w", err)
+		}
+		if marker[0] != 0xFF {
+			return fmt.Errorf("exif: malformed JPEG, expected marker, got %x", marker)
+		}
+
+		// Start-of-scan: everything after this is compressed image data,
+		// copied through verbatim.
+		if marker[1] == 0xDA {
+			if !replaced {
+				return fmt.Errorf("exif: no APP1 EXIF segment found in src to replace")
+			}
+			if _, err := dst.Write(marker[:]); err != nil {
+				return err
+			}
+			_, err := io.Copy(dst, br)
+			return err
+		}
+
+		var lenBuf [2]byte
+		if _, err := io.ReadFull(br, lenBuf[:]); err != nil {
+			return fmt.Errorf("exif: reading segment length: %w", err)
+		}
+		segLen := int(binary.BigEndian.Uint16(lenBuf[:]))
+		if segLen < 2 {
+			return fmt.Errorf("exif: invalid segment length %d", segLen)
+		}
+		payload := make([]byte, segLen-2)
+		if _, err := io.ReadFull(br, payload); err != nil {
+			return fmt.Errorf("exif: reading segment payload: %w", err)
+		}
+
+		if marker[1] == jpegAPP1 && len(payload) >= 6 && bytes.Equal(payload[:6], []byte("Exif\x00\x00")) {
+			if err := x.EncodeJPEG(dst); err != nil {
+				return fmt.Errorf("exif: encoding replacement APP1 segment: %w", err)
+			}
+			replaced = true
+			continue
+		}
+
+		if _, err := dst.Write(marker[:]); err != nil {
+			return err
+		}
+		if _, err := dst.Write(lenBuf[:]); err != nil {
+			return err
+		}
+		if _, err := dst.Write(payload); err != nil {
+			return err
+		}
+	}
+}
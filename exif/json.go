@@ -0,0 +1,54 @@
+package exif
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"github.com/rpajarola/exiftools/models"
+	"github.com/rpajarola/exiftools/tiff"
+)
+
+// ExiftoolJSON is the shape `exiftool -j` produces: an array with one
+// object per processed file, mapping field name to its string
+// representation.
+type ExiftoolJSON []map[string]string
+
+// MarshalExiftoolJSON renders x as a single-element ExiftoolJSON array,
+// so output can be diffed directly against `exiftool -j` for the same
+// file. This differs from MarshalJSON, which marshals the raw *tiff.Tag
+// values and is meant for round-tripping through this package rather than
+// interop with exiftool.
+func (x Exif) MarshalExiftoolJSON() ([]byte, error) {
+	obj := make(map[string]string, len(x.Fields))
+	for name, tag := range x.Fields {
+		obj[string(name)] = tag.String()
+	}
+	return json.Marshal(ExiftoolJSON{obj})
+}
+
+// UnmarshalExiftoolJSON parses a single-element ExiftoolJSON array (as
+// produced by MarshalExiftoolJSON, or by `exiftool -j` itself) into an
+// Exif with one ASCII tag per field.
+//
+// exiftool's JSON is already stringified, so this cannot recover the
+// original tag types (rational, short, etc.); every field comes back as a
+// DTAscii tag holding that string. Callers that need full fidelity should
+// round-trip through Exif.MarshalJSON/Encode instead.
+func UnmarshalExiftoolJSON(data []byte) (*Exif, error) {
+	var arr ExiftoolJSON
+	if err := json.Unmarshal(data, &arr); err != nil {
+		return nil, fmt.Errorf("exif: unmarshal exiftool json: %w", err)
+	}
+	if len(arr) == 0 {
+		return nil, fmt.Errorf("exif: unmarshal exiftool json: empty array")
+	}
+
+	x := New(nil, nil, nil)
+	for name, val := range arr[0] {
+		raw := append([]byte(val), 0)
+		tag := tiff.MakeTag(0, tiff.DTAscii, uint32(len(raw)), binary.BigEndian, raw)
+		x.Set(models.FieldName(name), tag)
+	}
+	return x, nil
+}
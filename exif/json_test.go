@@ -0,0 +1,42 @@
+package exif
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/rpajarola/exiftools/models"
+	"github.com/rpajarola/exiftools/tiff"
+)
+
+func TestMarshalExiftoolJSONRoundTrip(t *testing.T) {
+	x := New(nil, nil, nil)
+	x.Set(models.Make, tiff.MakeTag(0, tiff.DTAscii, 6, binary.BigEndian, []byte("Canon\x00")))
+
+	data, err := x.MarshalExiftoolJSON()
+	if err != nil {
+		t.Fatalf("MarshalExiftoolJSON: %v", err)
+	}
+
+	got, err := UnmarshalExiftoolJSON(data)
+	if err != nil {
+		t.Fatalf("UnmarshalExiftoolJSON: %v", err)
+	}
+
+	tag, err := got.Get(models.Make)
+	if err != nil {
+		t.Fatalf("Get(Make): %v", err)
+	}
+	val, err := tag.StringVal()
+	if err != nil {
+		t.Fatalf("StringVal: %v", err)
+	}
+	if val != "Canon" {
+		t.Errorf("round-tripped Make = %q, want %q", val, "Canon")
+	}
+}
+
+func TestUnmarshalExiftoolJSONEmpty(t *testing.T) {
+	if _, err := UnmarshalExiftoolJSON([]byte("[]")); err == nil {
+		t.Error("UnmarshalExiftoolJSON([]): expected error for empty array")
+	}
+}
@@ -43,7 +43,7 @@ func (p *parser) Parse(x *Exif) error {
 	x.LoadTags(x.Tiff.Dirs[0], models.ExifFields, keepUnknown)
 
 	// thumbnails
-	if len(x.Tiff.Dirs) >= 2 {
+	if len(x.Tiff.Dirs) >= 2 && !x.opts.SkipThumbnail {
 		x.LoadTags(x.Tiff.Dirs[1], models.ThumbnailFields, keepUnknown)
 	}
 
@@ -51,13 +51,13 @@ func (p *parser) Parse(x *Exif) error {
 
 	// recurse into exif, gps, and interop sub-IFDs
 	if err := x.loadSubDir(models.ExifIFDPointer, models.ExifFields); err != nil {
-		te[loadExif] = err.Error()
+		te[loadExif] = ExifError{cause: err}
 	}
 	if err := x.loadSubDir(models.GPSInfoIFDPointer, models.GpsFields); err != nil {
-		te[loadGPS] = err.Error()
+		te[loadGPS] = GPSError{cause: err}
 	}
 	if err := x.loadSubDir(models.InteroperabilityIFDPointer, models.InteropFields); err != nil {
-		te[loadInteroperability] = err.Error()
+		te[loadInteroperability] = InteropError{cause: err}
 	}
 	if len(te) > 0 {
 		return te
@@ -65,6 +65,55 @@ func (p *parser) Parse(x *Exif) error {
 	return nil
 }
 
+// runParsers invokes every registered Parser against x, accumulating any
+// failures into a *DecodeError instead of stopping at the first one, so
+// that (for example) a corrupt maker note doesn't also discard the GPS
+// sub-IFD a later-registered parser would otherwise have loaded. x itself
+// always carries whatever tags were successfully loaded regardless of
+// what runParsers returns.
+func runParsers(x *Exif) *DecodeError {
+	var errs *DecodeError
+	for _, p := range parsers {
+		if err := runParser(x, p); err != nil {
+			errs = errs.add(err)
+		}
+	}
+	return errs
+}
+
+// runParser invokes p.Parse(x), recovering a panic instead of letting it
+// abort the rest of Decode: maker-note parsers such as mknote's read
+// attacker-controlled offsets out of x.Raw, and a single malformed file
+// shouldn't crash a batch pipeline decoding many others alongside it.
+func runParser(x *Exif, p Parser) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = makerNoteError(x, p, fmt.Errorf("panic: %v", r))
+		}
+	}()
+
+	perr := p.Parse(x)
+	if perr == nil {
+		return nil
+	}
+	if _, ok := perr.(tiffErrors); ok {
+		// The default parser always returns a tiffErrors; any other
+		// error comes from a registered maker-note parser.
+		return perr
+	}
+	return makerNoteError(x, p, perr)
+}
+
+// makerNoteError wraps err as a MakerNoteError identifying p and, when
+// x has a MakerNote tag, the offset it was read from.
+func makerNoteError(x *Exif, p Parser, err error) MakerNoteError {
+	offset := int64(0)
+	if tag, gerr := x.Get(models.MakerNote); gerr == nil {
+		offset = int64(tag.ValOffset)
+	}
+	return MakerNoteError{Parser: fmt.Sprintf("%T", p), Offset: offset, cause: err}
+}
+
 func (x *Exif) loadSubDir(ptr models.FieldName, fieldMap map[uint16]models.FieldName) error {
 	r := bytes.NewReader(x.Raw)
 
@@ -1,8 +1,10 @@
 package exif
 
 import (
+	"bytes"
 	"fmt"
-	
+	"io"
+
 	"github.com/rpajarola/exiftools/models"
 )
 
@@ -17,28 +19,117 @@ var exifCompressionValues = map[uint16]string{
 	34933: "PNG",
 }
 
-// PreviewImageTag -
+// PreviewFormat identifies the image format of a preview or thumbnail
+// returned by ExtractPreviewImage/ExtractThumbnail, determined by
+// sniffing its own bytes rather than trusting the EXIF Compression tag
+// alone.
+type PreviewFormat int
+
+// Recognized PreviewFormat values.
+const (
+	PreviewFormatUnknown PreviewFormat = iota
+	PreviewFormatJPEG
+	PreviewFormatJP2
+	PreviewFormatPNG
+	PreviewFormatWebP
+	PreviewFormatJPEGXR
+)
+
+func (f PreviewFormat) String() string {
+	switch f {
+	case PreviewFormatJPEG:
+		return "JPEG"
+	case PreviewFormatJP2:
+		return "JPEG 2000"
+	case PreviewFormatPNG:
+		return "PNG"
+	case PreviewFormatWebP:
+		return "WebP"
+	case PreviewFormatJPEGXR:
+		return "JPEG XR"
+	default:
+		return "unknown"
+	}
+}
+
+var jp2Signature = []byte{0x00, 0x00, 0x00, 0x0C, 'j', 'P', ' ', ' ', 0x0D, 0x0A, 0x87, 0x0A}
+var pngSignature = []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n'}
+
+// sniffPreviewFormat identifies data's image format from its own magic
+// bytes, independent of whatever the EXIF Compression tag claimed.
+func sniffPreviewFormat(data []byte) PreviewFormat {
+	switch {
+	case len(data) >= 2 && data[0] == 0xFF && data[1] == 0xD8:
+		return PreviewFormatJPEG
+	case len(data) >= 12 && bytes.Equal(data[:12], jp2Signature):
+		return PreviewFormatJP2
+	case len(data) >= 8 && bytes.Equal(data[:8], pngSignature):
+		return PreviewFormatPNG
+	case len(data) >= 12 && bytes.Equal(data[0:4], []byte("RIFF")) && bytes.Equal(data[8:12], []byte("WEBP")):
+		return PreviewFormatWebP
+	case len(data) >= 4 && data[0] == 'I' && data[1] == 'I' && data[2] == 0xBC:
+		return PreviewFormatJPEGXR
+	default:
+		return PreviewFormatUnknown
+	}
+}
+
+// PreviewImageTag names a (start, length) tag pair to try as a preview
+// image candidate, plus an optional Compression tag to validate against
+// exifCompressionValues before accepting it. Packages outside exif (most
+// notably mknote, for MakerNote-specific previews such as Canon's
+// PreviewImageInfo or Nikon's PreviewIFD) construct their own via
+// NewPreviewImageTag and pass them to PreviewImage/ExtractPreviewImage
+// alongside the built-in IFD0/IFD1 candidates.
 type PreviewImageTag struct {
 	StartTag    models.FieldName
 	LengthTag   models.FieldName
 	Compression models.FieldName
-	Start       int
-	Length      int
+	// WidthTag, HeightTag, ColorSpaceTag, and DateTimeTag are consulted
+	// by ExtractPreviews/LargestPreview to fill in a Preview's
+	// Width/Height/ColorSpace/DateTime. They're optional, like
+	// Compression: pass models.FieldName("None") for whichever the
+	// candidate has no companion tag for.
+	WidthTag      models.FieldName
+	HeightTag     models.FieldName
+	ColorSpaceTag models.FieldName
+	DateTimeTag   models.FieldName
+	Start         int
+	Length        int
 }
 
-// NewPreviewImageTag -
+// NewPreviewImageTag builds a PreviewImageTag candidate from its three
+// source tags. Pass models.FieldName("None") for compression if the
+// candidate has no Compression tag of its own to validate against.
 func NewPreviewImageTag(start models.FieldName, length models.FieldName, compression models.FieldName) PreviewImageTag {
-	return PreviewImageTag{start, length, compression, 0, 0}
+	none := models.FieldName("None")
+	return NewPreviewImageTagFull(start, length, compression, none, none, none, none)
 }
 
-// PreviewImage returns the byte start location and length of the preview Image.
-func (x Exif) PreviewImage(tags ...PreviewImageTag) (start int64, length int64, err error) {
-	tags = append(tags,
-		NewPreviewImageTag(models.PreviewImageStart, models.PreviewImageLength, models.FieldName("None")),                        // IFD0 PreviewImage
-		NewPreviewImageTag(models.ThumbJPEGInterchangeFormat, models.ThumbJPEGInterchangeFormatLength, models.FieldName("None")), // IFD0 ThumbnailImage
-	)
+// NewPreviewImageTagFull is NewPreviewImageTag plus the companion tags
+// ExtractPreviews/LargestPreview use to populate a Preview's
+// Width/Height/ColorSpace/DateTime. Pass models.FieldName("None") for any
+// that don't apply.
+func NewPreviewImageTagFull(start, length, compression, width, height, colorSpace, dateTime models.FieldName) PreviewImageTag {
+	return PreviewImageTag{start, length, compression, width, height, colorSpace, dateTime, 0, 0}
+}
+
+// defaultPreviewImageTags are always tried by PreviewImage/
+// ExtractPreviewImage, in addition to whatever candidates the caller
+// passes in.
+func defaultPreviewImageTags() []PreviewImageTag {
+	return []PreviewImageTag{
+		NewPreviewImageTag(models.PreviewImageStart, models.PreviewImageLength, models.FieldName("None")),
+		NewPreviewImageTag(models.ThumbJPEGInterchangeFormat, models.ThumbJPEGInterchangeFormatLength, models.FieldName("None")),
+	}
+}
+
+// resolvePreviewCandidates fills in Start/Length (and drops any candidate
+// whose Compression tag names a value not in exifCompressionValues) for
+// each of tags, appending defaultPreviewImageTags.
+func (x Exif) resolvePreviewCandidates(tags ...PreviewImageTag) []PreviewImageTag {
+	tags = append(tags, defaultPreviewImageTags()...)
 	for i, tag := range tags {
-		// If Preview Image is of type JPEG, PNG, WEBP else continue
 		if tag.Compression != models.FieldName("None") {
 			compression, err := x.Get(tag.Compression)
 			if err == nil {
@@ -46,8 +137,7 @@ func (x Exif) PreviewImage(tags ...PreviewImageTag) (start int64, length int64,
 				if err != nil {
 					continue
 				}
-				_, ok := exifCompressionValues[uint16(c)]
-				if !ok {
+				if _, ok := exifCompressionValues[uint16(c)]; !ok {
 					continue
 				}
 			}
@@ -56,7 +146,7 @@ func (x Exif) PreviewImage(tags ...PreviewImageTag) (start int64, length int64,
 		if err != nil {
 			continue
 		}
-		tags[i].Start, err = offset.Int(0)
+		start, err := offset.Int(0)
 		if err != nil {
 			continue
 		}
@@ -64,18 +154,90 @@ func (x Exif) PreviewImage(tags ...PreviewImageTag) (start int64, length int64,
 		if err != nil {
 			continue
 		}
-		tags[i].Length, err = length.Int(0)
+		l, err := length.Int(0)
 		if err != nil {
 			continue
 		}
+		tags[i].Start = start
+		tags[i].Length = l
 	}
+	return tags
+}
 
+// PreviewImage returns the byte start location and length, relative to
+// x.Raw's origin (the start of the TIFF header), of the largest preview
+// image found among tags and the built-in IFD0/IFD1 candidates.
+func (x Exif) PreviewImage(tags ...PreviewImageTag) (start int64, length int64, err error) {
 	var maxTag PreviewImageTag
-	for i := range tags {
-		if tags[i].Length > maxTag.Length {
-			maxTag = tags[i]
+	for _, tag := range x.resolvePreviewCandidates(tags...) {
+		if tag.Length > maxTag.Length {
+			maxTag = tag
 		}
 	}
-	fmt.Println(maxTag)
+	if maxTag.Length == 0 {
+		return 0, 0, TagNotPresentError(models.PreviewImageStart)
+	}
 	return int64(maxTag.Start), int64(maxTag.Length), nil
 }
+
+// readRange returns the length bytes starting at start, preferring
+// x.Raw (the decoded EXIF payload already in memory) and falling back
+// to r only when the range falls outside it, e.g. because MaxExifSize
+// truncated x.Raw before the preview bytes. r, if given, must share
+// x.Raw's origin: offset 0 is the start of the TIFF header, the same
+// convention DecodeAt's io.SectionReader and bytes.NewReader(x.Raw) both
+// follow.
+func (x *Exif) readRange(r io.ReaderAt, start, length int64) ([]byte, error) {
+	if start >= 0 && length >= 0 && start+length <= int64(len(x.Raw)) {
+		return x.Raw[start : start+length], nil
+	}
+	if r == nil {
+		return nil, fmt.Errorf("exif: preview image at offset %d (%d bytes) extends beyond the decoded EXIF data (%d bytes) and no reader was given to read the original file", start, length, len(x.Raw))
+	}
+	buf := make([]byte, length)
+	if _, err := r.ReadAt(buf, start); err != nil {
+		return nil, fmt.Errorf("exif: reading preview image: %w", err)
+	}
+	return buf, nil
+}
+
+// ExtractPreviewImage locates the largest valid preview image among tags
+// and the built-in IFD0/IFD1 candidates, and returns its decoded bytes
+// and format. r, if non-nil, is used to read bytes beyond what's left in
+// x.Raw; see readRange.
+func (x *Exif) ExtractPreviewImage(r io.ReaderAt, tags ...PreviewImageTag) ([]byte, PreviewFormat, error) {
+	start, length, err := x.PreviewImage(tags...)
+	if err != nil {
+		return nil, PreviewFormatUnknown, err
+	}
+	data, err := x.readRange(r, start, length)
+	if err != nil {
+		return nil, PreviewFormatUnknown, err
+	}
+	format := sniffPreviewFormat(data)
+	if format == PreviewFormatUnknown {
+		return nil, PreviewFormatUnknown, fmt.Errorf("exif: preview image at offset %d is not a recognized image format", start)
+	}
+	return data, format, nil
+}
+
+// ExtractThumbnail is ExtractPreviewImage restricted to IFD1's
+// ThumbJPEGInterchangeFormat/Length tags: the small JPEG most cameras
+// embed for in-camera playback, as distinct from the higher-resolution
+// preview ExtractPreviewImage may find via MakerNote candidates.
+func (x *Exif) ExtractThumbnail(r io.ReaderAt) ([]byte, PreviewFormat, error) {
+	tag := NewPreviewImageTag(models.ThumbJPEGInterchangeFormat, models.ThumbJPEGInterchangeFormatLength, models.FieldName("None"))
+	resolved := x.resolvePreviewCandidates(tag)[0]
+	if resolved.Length == 0 {
+		return nil, PreviewFormatUnknown, TagNotPresentError(models.ThumbJPEGInterchangeFormat)
+	}
+	data, err := x.readRange(r, int64(resolved.Start), int64(resolved.Length))
+	if err != nil {
+		return nil, PreviewFormatUnknown, err
+	}
+	format := sniffPreviewFormat(data)
+	if format == PreviewFormatUnknown {
+		return nil, PreviewFormatUnknown, fmt.Errorf("exif: thumbnail at offset %d is not a recognized image format", resolved.Start)
+	}
+	return data, format, nil
+}
@@ -0,0 +1,221 @@
+package exif
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"io"
+
+	"github.com/rpajarola/exiftools/models"
+)
+
+// TIFF/DNG Compression tag values ExtractPreviews knows how to read a
+// preview out of.
+const (
+	compressionUncompressed = 1
+	compressionJPEGOld      = 6
+	compressionJPEG         = 7
+	compressionLossyJPEG    = 34892 // DNG-specific: lossy (not baseline) JPEG
+)
+
+// previewFormatMIME maps a sniffed PreviewFormat to its MIME type.
+var previewFormatMIME = map[PreviewFormat]string{
+	PreviewFormatJPEG:   "image/jpeg",
+	PreviewFormatJP2:    "image/jp2",
+	PreviewFormatPNG:    "image/png",
+	PreviewFormatWebP:   "image/webp",
+	PreviewFormatJPEGXR: "image/vnd.ms-photo",
+}
+
+// Preview is one preview or thumbnail image ExtractPreviews found, sourced
+// from a PreviewImageTag's resolved (Start, Length) byte range within
+// x.Raw (or r, for a range MaxExifSize truncated out of x.Raw).
+// Width/Height/ColorSpace/DateTime come from the candidate's companion
+// tags (see NewPreviewImageTagFull) and are zero/empty when it didn't
+// declare one.
+type Preview struct {
+	Width      int
+	Height     int
+	ColorSpace int
+	DateTime   string
+	Reader     io.Reader
+	MIME       string
+}
+
+// resolvedDims reads tag's WidthTag/HeightTag/ColorSpaceTag/DateTimeTag
+// from x, leaving the zero value for whichever isn't present.
+func (x *Exif) resolvedDims(tag PreviewImageTag) (width, height, colorSpace int, dateTime string) {
+	none := models.FieldName("None")
+	if tag.WidthTag != none {
+		if t, err := x.Get(tag.WidthTag); err == nil {
+			width, _ = t.Int(0)
+		}
+	}
+	if tag.HeightTag != none {
+		if t, err := x.Get(tag.HeightTag); err == nil {
+			height, _ = t.Int(0)
+		}
+	}
+	if tag.ColorSpaceTag != none {
+		if t, err := x.Get(tag.ColorSpaceTag); err == nil {
+			colorSpace, _ = t.Int(0)
+		}
+	}
+	if tag.DateTimeTag != none {
+		if t, err := x.Get(tag.DateTimeTag); err == nil {
+			dateTime, _ = t.StringVal()
+		}
+	}
+	return
+}
+
+// sectionReader returns a bounded reader over [start, start+length),
+// preferring x.Raw and falling back to r; see readRange.
+func (x *Exif) sectionReader(r io.ReaderAt, start, length int64) (*io.SectionReader, bool) {
+	if start >= 0 && length >= 0 && start+length <= int64(len(x.Raw)) {
+		return io.NewSectionReader(bytes.NewReader(x.Raw), start, length), true
+	}
+	if r == nil {
+		return nil, false
+	}
+	return io.NewSectionReader(r, start, length), true
+}
+
+// assembleUncompressed builds an *image.RGBA from a contiguous buffer of
+// interleaved 8-bit RGB samples: the layout a DNG preview SubIFD uses
+// when its Compression tag is 1. It doesn't handle planar, sub-8-bit, or
+// raw Bayer data - those aren't "previews" in the sense this API targets,
+// and a camera's raw SubIFD never carries both Compression == 1 and a
+// PreviewColorSpace tag the way its preview SubIFDs do.
+func assembleUncompressed(data []byte, width, height int) (*image.RGBA, error) {
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("exif: uncompressed preview has no Width/Height to assemble from")
+	}
+	want := width * height * 3
+	if len(data) < want {
+		return nil, fmt.Errorf("exif: uncompressed preview is %d bytes, want at least %d for %dx%d RGB", len(data), want, width, height)
+	}
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for px := 0; px < width; px++ {
+			src := data[(y*width+px)*3:]
+			dst := img.PixOffset(px, y)
+			img.Pix[dst] = src[0]
+			img.Pix[dst+1] = src[1]
+			img.Pix[dst+2] = src[2]
+			img.Pix[dst+3] = 0xFF
+		}
+	}
+	return img, nil
+}
+
+// ExtractPreviews locates every valid preview or thumbnail among tags and
+// the built-in IFD0/IFD1 candidates (the same set PreviewImage resolves),
+// and returns each as a Preview. r, if non-nil, is used to read bytes
+// beyond what's left in x.Raw; see readRange.
+//
+// Uncompressed previews (Compression == 1) are assembled into an
+// *image.RGBA and handed back via a Reader that replays its pixel bytes.
+// JPEG-family previews (Compression 6, 7, or the DNG-specific lossy-JPEG
+// value 34892) are returned as a bounded *io.SectionReader over the
+// original bytes, so a caller can pass it straight to image/jpeg.Decode
+// without an extra copy. Any other Compression value is skipped.
+func (x *Exif) ExtractPreviews(r io.ReaderAt, tags ...PreviewImageTag) ([]Preview, error) {
+	none := models.FieldName("None")
+	var previews []Preview
+
+	for _, tag := range x.resolvePreviewCandidates(tags...) {
+		if tag.Length == 0 {
+			continue
+		}
+
+		compression := compressionJPEG // the default candidates (JPEG thumbnails) declare no Compression tag
+		if tag.Compression != none {
+			t, err := x.Get(tag.Compression)
+			if err != nil {
+				continue
+			}
+			compression, err = t.Int(0)
+			if err != nil {
+				continue
+			}
+		}
+
+		width, height, colorSpace, dateTime := x.resolvedDims(tag)
+
+		if compression == compressionUncompressed {
+			data, err := x.readRange(r, int64(tag.Start), int64(tag.Length))
+			if err != nil {
+				continue
+			}
+			img, err := assembleUncompressed(data, width, height)
+			if err != nil {
+				continue
+			}
+			previews = append(previews, Preview{
+				Width:      width,
+				Height:     height,
+				ColorSpace: colorSpace,
+				DateTime:   dateTime,
+				Reader:     bytes.NewReader(img.Pix),
+				MIME:       "image/x-rgba",
+			})
+			continue
+		}
+
+		if compression != compressionJPEGOld && compression != compressionJPEG && compression != compressionLossyJPEG {
+			continue
+		}
+
+		sr, ok := x.sectionReader(r, int64(tag.Start), int64(tag.Length))
+		if !ok {
+			continue
+		}
+		header, err := x.readRange(r, int64(tag.Start), min64(int64(tag.Length), 16))
+		if err != nil {
+			continue
+		}
+		mime := previewFormatMIME[sniffPreviewFormat(header)]
+		if mime == "" {
+			mime = "application/octet-stream"
+		}
+		previews = append(previews, Preview{
+			Width:      width,
+			Height:     height,
+			ColorSpace: colorSpace,
+			DateTime:   dateTime,
+			Reader:     sr,
+			MIME:       mime,
+		})
+	}
+
+	if len(previews) == 0 {
+		return nil, TagNotPresentError(models.PreviewImageStart)
+	}
+	return previews, nil
+}
+
+// LargestPreview is ExtractPreviews restricted to the single
+// highest-resolution preview found (by Width*Height; ties keep whichever
+// was found first, including a preview with no Width/Height tag of its
+// own, which sorts last since its area is 0).
+func (x *Exif) LargestPreview(r io.ReaderAt, tags ...PreviewImageTag) (Preview, error) {
+	previews, err := x.ExtractPreviews(r, tags...)
+	if err != nil {
+		return Preview{}, err
+	}
+	best := previews[0]
+	for _, p := range previews[1:] {
+		if p.Width*p.Height > best.Width*best.Height {
+			best = p
+		}
+	}
+	return best, nil
+}
+
+func min64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,50 @@
+package exif
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/rpajarola/exiftools/models"
+	"github.com/rpajarola/exiftools/tiff"
+)
+
+func TestExtractPreviewsThumbnail(t *testing.T) {
+	data := []byte{0xFF, 0xD8, 0x00, 0x00, 0xFF, 0xD9}
+	x := New(nil, data, nil)
+	x.Fields[models.ThumbJPEGInterchangeFormat] = tiff.MakeIntTag(0x0201, 0)
+	x.Fields[models.ThumbJPEGInterchangeFormatLength] = tiff.MakeIntTag(0x0202, len(data))
+
+	previews, err := x.ExtractPreviews(nil)
+	if err != nil {
+		t.Fatalf("ExtractPreviews: %v", err)
+	}
+	if len(previews) != 1 {
+		t.Fatalf("got %d previews, want 1", len(previews))
+	}
+	if previews[0].MIME != "image/jpeg" {
+		t.Errorf("MIME = %q, want image/jpeg", previews[0].MIME)
+	}
+	got, err := io.ReadAll(previews[0].Reader)
+	if err != nil {
+		t.Fatalf("reading preview: %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("preview bytes = %x, want %x", got, data)
+	}
+
+	largest, err := x.LargestPreview(nil)
+	if err != nil {
+		t.Fatalf("LargestPreview: %v", err)
+	}
+	if largest.MIME != "image/jpeg" {
+		t.Errorf("LargestPreview MIME = %q, want image/jpeg", largest.MIME)
+	}
+}
+
+func TestExtractPreviewsNoneFound(t *testing.T) {
+	x := New(nil, nil, nil)
+	if _, err := x.ExtractPreviews(nil); err == nil {
+		t.Error("ExtractPreviews on an Exif with no preview tags: got nil error, want one")
+	}
+}
@@ -0,0 +1,57 @@
+package exif
+
+import (
+	"io"
+
+	"github.com/rpajarola/exiftools/models"
+	"github.com/rpajarola/exiftools/tiff"
+)
+
+// Scanner provides pull-style access to EXIF tags, for batch pipelines
+// (e.g. indexing tens of thousands of photos) that want to inspect a few
+// fields per file without paying for Walk's callback indirection or for
+// materializing the full Fields map up front via LoadTags.
+//
+// Scanner still decodes the underlying TIFF/container structure eagerly
+// (the tiff package does not expose a lazy per-tag reader), but Scan/Tag
+// let a caller stop as soon as it has what it needs instead of visiting
+// every field.
+type Scanner struct {
+	x     *Exif
+	names []models.FieldName
+	i     int
+}
+
+// NewScanner decodes r (same formats Decode accepts) with opts and returns
+// a Scanner positioned before the first field.
+func NewScanner(r io.Reader, opts *DecodeOptions) (*Scanner, error) {
+	x, err := DecodeWithOptions(r, opts)
+	if x == nil {
+		return nil, err
+	}
+	names := make([]models.FieldName, 0, len(x.Fields))
+	for name := range x.Fields {
+		names = append(names, name)
+	}
+	return &Scanner{x: x, names: names, i: -1}, err
+}
+
+// Scan advances the Scanner to the next field, returning false once there
+// are none left.
+func (s *Scanner) Scan() bool {
+	s.i++
+	return s.i < len(s.names)
+}
+
+// Tag returns the field name and tag at the Scanner's current position.
+// It must only be called after a call to Scan that returned true.
+func (s *Scanner) Tag() (models.FieldName, *tiff.Tag) {
+	name := s.names[s.i]
+	return name, s.x.Fields[name]
+}
+
+// Exif returns the underlying decoded Exif object, e.g. to call
+// convenience accessors (DateTime, LatLong, ...) once scanning is done.
+func (s *Scanner) Exif() *Exif {
+	return s.x
+}
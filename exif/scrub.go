@@ -0,0 +1,320 @@
+package exif
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/rpajarola/exiftools/container"
+	"github.com/rpajarola/exiftools/models"
+)
+
+// ScrubPolicy controls which metadata Scrub keeps.
+//
+// GPS, MakerNote (including the Sony 0x9050 binary block), XMP, IPTC, and
+// Photoshop metadata are always dropped; there is no allowlist exception
+// for them, since a caller wanting those categories kept shouldn't be
+// calling Scrub in the first place. AllowFields governs everything else:
+// the ordinary top-level TIFF/Exif IFD tags (Orientation, ColorSpace,
+// a stored ICC_Profile tag, DateTime, ...).
+type ScrubPolicy struct {
+	// AllowFields lists the IFD fields Scrub copies through unchanged;
+	// every field not named here is dropped along with the categories
+	// above.
+	AllowFields []models.FieldName
+	// Strict makes Scrub fail closed on any JPEG APPn segment it can't
+	// classify as one of the known-safe (JFIF, ICC_PROFILE, Adobe) or
+	// known-to-drop (Exif, XMP, Photoshop/IPTC) kinds, rather than
+	// silently dropping it. PNG and WebP aren't affected: their chunk
+	// FourCCs are a small, closed, well-documented set, so there's no
+	// meaningful "unclassifiable" case to fail closed on the way there
+	// is for an arbitrary vendor APPn segment.
+	Strict bool
+}
+
+func (p ScrubPolicy) allows(name models.FieldName) bool {
+	for _, f := range p.AllowFields {
+		if f == name {
+			return true
+		}
+	}
+	return false
+}
+
+// alwaysDropped reports whether name is GPS or MakerNote data, which
+// scrubbedTiff drops unconditionally: AllowFields has no way to override
+// this, so a caller can't accidentally (or otherwise) defeat Scrub's core
+// guarantee by naming "GPSLatitude" or "MakerNote" in its allowlist.
+func alwaysDropped(name models.FieldName) bool {
+	return name == models.MakerNote || strings.HasPrefix(string(name), "GPS")
+}
+
+// Scrub reads an image from r, removes its GPS, MakerNote, XMP, IPTC, and
+// Photoshop metadata per policy, and writes the result to w. It operates
+// segment/chunk-wise rather than by fully parsing and re-serializing the
+// file, so compressed image data is copied through byte-identical and a
+// MakerNote this package can't decode is still safely dropped rather than
+// causing an error.
+//
+// JPEG, PNG, and WebP are supported; any other format is an error.
+func Scrub(r io.Reader, w io.Writer, policy ScrubPolicy) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("exif: scrub: reading input: %w", err)
+	}
+
+	switch container.Sniff(data) {
+	case container.FormatJPEG:
+		return scrubJPEG(w, data, policy)
+	case container.FormatPNG:
+		return scrubPNG(w, data, policy)
+	case container.FormatWebP:
+		return scrubWebP(w, data, policy)
+	default:
+		return fmt.Errorf("exif: scrub: unsupported or unrecognized container format")
+	}
+}
+
+// scrubbedTiff re-encodes the TIFF/Exif structure found in jpegData (if
+// any) keeping only policy.AllowFields, and returns the replacement APP1
+// segment bytes (marker, length, "Exif\x00\x00" header, and TIFF stream,
+// exactly as EncodeJPEG writes it). ok is false if jpegData has no EXIF
+// to scrub, in which case the original APP1-Exif segment (if the caller
+// finds one while walking) should just be dropped outright.
+func scrubbedTiff(jpegData []byte, policy ScrubPolicy) (replacement []byte, ok bool) {
+	x, err := Decode(bytes.NewReader(jpegData))
+	if err != nil {
+		return nil, false
+	}
+
+	scrubbed := New(x.Tiff, nil, nil)
+	for name, tag := range x.Fields {
+		if alwaysDropped(name) {
+			continue
+		}
+		if policy.allows(name) {
+			scrubbed.Set(name, tag)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := scrubbed.EncodeJPEG(&buf); err != nil {
+		return nil, false
+	}
+	return buf.Bytes(), true
+}
+
+// jpegSegmentKind classifies a JPEG APPn/COM segment's payload for
+// Scrub's purposes.
+type jpegSegmentKind int
+
+const (
+	segSafe    jpegSegmentKind = iota // never metadata Scrub cares about
+	segExif                           // APP1 "Exif\x00\x00": rewritten, not dropped outright
+	segDrop                           // XMP, Photoshop/IPTC: always dropped
+	segUnknown                        // unrecognized APPn: dropped (lenient) or an error (strict)
+)
+
+var (
+	exifSignature      = []byte("Exif\x00\x00")
+	xmpSignature       = []byte("http://ns.adobe.com/xap/1.0/\x00")
+	photoshopSignature = []byte("Photoshop 3.0\x00")
+	jfifSignature      = []byte("JFIF\x00")
+	iccProfileSig      = []byte("ICC_PROFILE\x00")
+	adobeSignature     = []byte("Adobe")
+)
+
+// classifyJPEGSegment identifies marker m's payload.
+func classifyJPEGSegment(m byte, payload []byte) jpegSegmentKind {
+	if m == 0xFE { // COM: a plain-text comment, not one of Scrub's named categories
+		return segSafe
+	}
+	if m < 0xE0 || m > 0xEF { // not an APPn segment at all (SOF, DQT, DHT, DRI, ...)
+		return segSafe
+	}
+	switch {
+	case m == jpegAPP1 && bytes.HasPrefix(payload, exifSignature):
+		return segExif
+	case m == jpegAPP1 && bytes.HasPrefix(payload, xmpSignature):
+		return segDrop
+	case bytes.HasPrefix(payload, photoshopSignature): // IPTC lives inside this APP13 segment
+		return segDrop
+	case m == 0xE0 && bytes.HasPrefix(payload, jfifSignature):
+		return segSafe
+	case m == 0xE2 && bytes.HasPrefix(payload, iccProfileSig):
+		return segSafe
+	case m == 0xEE && bytes.HasPrefix(payload, adobeSignature):
+		return segSafe
+	default:
+		return segUnknown
+	}
+}
+
+// scrubJPEG walks data's JPEG markers, copying everything through
+// unchanged except the segments classifyJPEGSegment flags: the EXIF
+// APP1 is replaced with a re-encoded, field-filtered one (or dropped if
+// scrubbedTiff finds nothing worth keeping), XMP/Photoshop/IPTC segments
+// are dropped, and unknown APPn segments are dropped (or rejected, in
+// strict mode).
+func scrubJPEG(dst io.Writer, data []byte, policy ScrubPolicy) error {
+	replacement, haveReplacement := scrubbedTiff(data, policy)
+
+	br := bufio.NewReader(bytes.NewReader(data))
+
+	var soi [2]byte
+	if _, err := io.ReadFull(br, soi[:]); err != nil {
+		return fmt.Errorf("exif: scrub: reading SOI: %w", err)
+	}
+	if soi[0] != 0xFF || soi[1] != 0xD8 {
+		return fmt.Errorf("exif: scrub: not a JPEG file (bad SOI marker)")
+	}
+	if _, err := dst.Write(soi[:]); err != nil {
+		return err
+	}
+
+	for {
+		var marker [2]byte
+		if _, err := io.ReadFull(br, marker[:]); err != nil {
+			return fmt.Errorf("exif: scrub: reading segment marker: %w", err)
+		}
+		if marker[0] != 0xFF {
+			return fmt.Errorf("exif: scrub: malformed JPEG, expected marker, got %x", marker)
+		}
+
+		if marker[1] == 0xDA { // start of scan: everything after is compressed image data
+			if _, err := dst.Write(marker[:]); err != nil {
+				return err
+			}
+			_, err := io.Copy(dst, br)
+			return err
+		}
+		if marker[1] == 0xD8 || marker[1] == 0xD9 || (marker[1] >= 0xD0 && marker[1] <= 0xD7) {
+			if _, err := dst.Write(marker[:]); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var lenBuf [2]byte
+		if _, err := io.ReadFull(br, lenBuf[:]); err != nil {
+			return fmt.Errorf("exif: scrub: reading segment length: %w", err)
+		}
+		segLen := int(binary.BigEndian.Uint16(lenBuf[:]))
+		if segLen < 2 {
+			return fmt.Errorf("exif: scrub: invalid segment length %d", segLen)
+		}
+		payload := make([]byte, segLen-2)
+		if _, err := io.ReadFull(br, payload); err != nil {
+			return fmt.Errorf("exif: scrub: reading segment payload: %w", err)
+		}
+
+		switch kind := classifyJPEGSegment(marker[1], payload); kind {
+		case segExif:
+			if haveReplacement {
+				if _, err := dst.Write(replacement); err != nil {
+					return err
+				}
+			}
+			// else: drop it, nothing survived the field allowlist.
+		case segDrop:
+			// dropped
+		case segUnknown:
+			if policy.Strict {
+				return fmt.Errorf("exif: scrub: strict mode: unclassified APP%X segment", marker[1]-0xE0)
+			}
+			// lenient: dropped
+		case segSafe:
+			if _, err := dst.Write(marker[:]); err != nil {
+				return err
+			}
+			if _, err := dst.Write(lenBuf[:]); err != nil {
+				return err
+			}
+			if _, err := dst.Write(payload); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// scrubPNG walks data's chunks, dropping tEXt/iTXt/zTXt (the ancillary
+// text chunks used to smuggle EXIF/XMP/IPTC as hex- or otherwise-encoded
+// text, see container.extractPNG) and eXIf (the modern native EXIF
+// chunk), and copying every other chunk through unchanged.
+func scrubPNG(dst io.Writer, data []byte, policy ScrubPolicy) error {
+	if len(data) < 8 {
+		return fmt.Errorf("exif: scrub: not a PNG file (too short)")
+	}
+	if _, err := dst.Write(data[:8]); err != nil {
+		return err
+	}
+
+	pos := 8
+	for pos+8 <= len(data) {
+		length := int(binary.BigEndian.Uint32(data[pos : pos+4]))
+		typ := string(data[pos+4 : pos+8])
+		chunkEnd := pos + 12 + length
+		if chunkEnd > len(data) {
+			return fmt.Errorf("exif: scrub: truncated PNG chunk %q", typ)
+		}
+
+		switch typ {
+		case "tEXt", "iTXt", "zTXt", "eXIf":
+			// dropped
+		default:
+			if _, err := dst.Write(data[pos:chunkEnd]); err != nil {
+				return err
+			}
+		}
+		pos = chunkEnd
+	}
+	return nil
+}
+
+// scrubWebP walks data's RIFF chunks, dropping the "EXIF" and "XMP "
+// chunks and copying every other chunk through unchanged, then rewrites
+// the RIFF header's overall size field to match.
+func scrubWebP(dst io.Writer, data []byte, policy ScrubPolicy) error {
+	if len(data) < 12 {
+		return fmt.Errorf("exif: scrub: not a WebP file (too short)")
+	}
+
+	var body bytes.Buffer
+	body.Write(data[8:12]) // "WEBP"
+
+	pos := 12
+	for pos+8 <= len(data) {
+		fourCC := string(data[pos : pos+4])
+		size := int(binary.LittleEndian.Uint32(data[pos+4 : pos+8]))
+		padded := size
+		if padded%2 == 1 {
+			padded++
+		}
+		chunkEnd := pos + 8 + padded
+		if chunkEnd > len(data) {
+			return fmt.Errorf("exif: scrub: truncated WebP chunk %q", fourCC)
+		}
+
+		switch fourCC {
+		case "EXIF", "XMP ":
+			// dropped
+		default:
+			body.Write(data[pos:chunkEnd])
+		}
+		pos = chunkEnd
+	}
+
+	if _, err := dst.Write([]byte("RIFF")); err != nil {
+		return err
+	}
+	var sizeBuf [4]byte
+	binary.LittleEndian.PutUint32(sizeBuf[:], uint32(body.Len()))
+	if _, err := dst.Write(sizeBuf[:]); err != nil {
+		return err
+	}
+	_, err := dst.Write(body.Bytes())
+	return err
+}
@@ -0,0 +1,159 @@
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/rpajarola/exiftools/models"
+	"github.com/rpajarola/exiftools/tiff"
+)
+
+// TestScrubJPEGDropsGPSAndAppliesAllowlist exercises scrubJPEG's primary
+// path end to end: decode the embedded EXIF, filter its fields, re-encode
+// it, and splice the result back into the JPEG segment stream. It also
+// confirms GPSAltitude is dropped even though it's named in AllowFields,
+// since alwaysDropped must win regardless of policy.
+func TestScrubJPEGDropsGPSAndAppliesAllowlist(t *testing.T) {
+	x := New(&tiff.Tiff{Order: binary.BigEndian}, nil, nil)
+	x.Set(models.OrientationTag, tiff.MakeIntTag(0x0112, 1))
+	x.Set(models.GPSAltitude, tiff.MakeIntTag(0x0006, 100))
+
+	var exifSeg bytes.Buffer
+	if err := x.EncodeJPEG(&exifSeg); err != nil {
+		t.Fatalf("EncodeJPEG: %v", err)
+	}
+
+	var src bytes.Buffer
+	src.Write([]byte{0xFF, 0xD8}) // SOI
+	src.Write(exifSeg.Bytes())
+	src.Write([]byte{0xFF, 0xDA}) // SOS
+	src.WriteString("fakescandata")
+
+	policy := ScrubPolicy{AllowFields: []models.FieldName{models.OrientationTag, models.GPSAltitude}}
+
+	var dst bytes.Buffer
+	if err := scrubJPEG(&dst, src.Bytes(), policy); err != nil {
+		t.Fatalf("scrubJPEG: %v", err)
+	}
+
+	out, err := Decode(bytes.NewReader(dst.Bytes()))
+	if err != nil {
+		t.Fatalf("re-decoding scrubbed JPEG: %v", err)
+	}
+	if _, err := out.Get(models.GPSAltitude); err == nil {
+		t.Error("scrubbed JPEG still has GPSAltitude, even though it was explicitly allowlisted")
+	}
+	if _, err := out.Get(models.OrientationTag); err != nil {
+		t.Errorf("scrubbed JPEG lost allowlisted OrientationTag: %v", err)
+	}
+	if !bytes.Contains(dst.Bytes(), []byte("fakescandata")) {
+		t.Error("scrubbed JPEG lost its compressed scan data")
+	}
+}
+
+func pngChunk(typ string, data []byte) []byte {
+	var buf bytes.Buffer
+	var lenBuf [4]byte
+	binary.BigEndian.PutUint32(lenBuf[:], uint32(len(data)))
+	buf.Write(lenBuf[:])
+	buf.WriteString(typ)
+	buf.Write(data)
+	buf.Write([]byte{0, 0, 0, 0}) // CRC, unchecked by scrubPNG
+	return buf.Bytes()
+}
+
+func TestScrubPNGRemovesMetadataChunks(t *testing.T) {
+	var src bytes.Buffer
+	src.Write([]byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n'})
+	src.Write(pngChunk("IHDR", make([]byte, 13)))
+	src.Write(pngChunk("tEXt", []byte("Raw profile type exif\x00...")))
+	src.Write(pngChunk("eXIf", []byte("fakeexifdata")))
+	src.Write(pngChunk("IDAT", []byte("fakeimagedata")))
+	src.Write(pngChunk("IEND", nil))
+
+	var dst bytes.Buffer
+	if err := scrubPNG(&dst, src.Bytes(), ScrubPolicy{}); err != nil {
+		t.Fatalf("scrubPNG: %v", err)
+	}
+
+	out := dst.Bytes()
+	if bytes.Contains(out, []byte("tEXt")) || bytes.Contains(out, []byte("eXIf")) {
+		t.Errorf("scrubbed PNG still contains a metadata chunk: %x", out)
+	}
+	if !bytes.Contains(out, []byte("IDAT")) || !bytes.Contains(out, []byte("IEND")) {
+		t.Errorf("scrubbed PNG lost a non-metadata chunk: %x", out)
+	}
+}
+
+func webpChunk(fourCC string, data []byte) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(fourCC)
+	var sizeBuf [4]byte
+	binary.LittleEndian.PutUint32(sizeBuf[:], uint32(len(data)))
+	buf.Write(sizeBuf[:])
+	buf.Write(data)
+	if len(data)%2 == 1 {
+		buf.WriteByte(0)
+	}
+	return buf.Bytes()
+}
+
+func TestScrubWebPRemovesMetadataChunks(t *testing.T) {
+	vp8 := webpChunk("VP8 ", []byte("fakevp8data"))
+	exifChunk := webpChunk("EXIF", []byte("fakeexifdata"))
+
+	var body bytes.Buffer
+	body.WriteString("WEBP")
+	body.Write(vp8)
+	body.Write(exifChunk)
+
+	var src bytes.Buffer
+	src.WriteString("RIFF")
+	var sizeBuf [4]byte
+	binary.LittleEndian.PutUint32(sizeBuf[:], uint32(body.Len()))
+	src.Write(sizeBuf[:])
+	src.Write(body.Bytes())
+
+	var dst bytes.Buffer
+	if err := scrubWebP(&dst, src.Bytes(), ScrubPolicy{}); err != nil {
+		t.Fatalf("scrubWebP: %v", err)
+	}
+
+	out := dst.Bytes()
+	if !bytes.HasPrefix(out, []byte("RIFF")) {
+		t.Fatalf("scrubbed WebP missing RIFF header: %x", out)
+	}
+	gotSize := binary.LittleEndian.Uint32(out[4:8])
+	if int(gotSize) != len(out)-8 {
+		t.Errorf("RIFF size field %d doesn't match body length %d", gotSize, len(out)-8)
+	}
+	if bytes.Contains(out, []byte("EXIF")) {
+		t.Errorf("scrubbed WebP still contains an EXIF chunk: %x", out)
+	}
+	if !bytes.Contains(out, []byte("VP8 ")) {
+		t.Errorf("scrubbed WebP lost its VP8 chunk: %x", out)
+	}
+}
+
+func TestClassifyJPEGSegment(t *testing.T) {
+	cases := []struct {
+		name    string
+		marker  byte
+		payload []byte
+		want    jpegSegmentKind
+	}{
+		{"jfif", 0xE0, []byte("JFIF\x00\x01\x02"), segSafe},
+		{"exif", 0xE1, []byte("Exif\x00\x00\xFF"), segExif},
+		{"xmp", 0xE1, []byte("http://ns.adobe.com/xap/1.0/\x00<x:xmpmeta/>"), segDrop},
+		{"photoshop", 0xED, []byte("Photoshop 3.0\x00\x00"), segDrop},
+		{"icc", 0xE2, []byte("ICC_PROFILE\x00\x01\x02"), segSafe},
+		{"unknown app12", 0xEC, []byte("whatever a random tool writes here"), segUnknown},
+		{"comment", 0xFE, []byte("hand-edited in an image tool"), segSafe},
+	}
+	for _, c := range cases {
+		if got := classifyJPEGSegment(c.marker, c.payload); got != c.want {
+			t.Errorf("%s: classifyJPEGSegment() = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
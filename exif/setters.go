@@ -0,0 +1,117 @@
+package exif
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/rpajarola/exiftools/models"
+	"github.com/rpajarola/exiftools/tiff"
+)
+
+// SetOrientation sets the Orientation tag, overwriting any previous value.
+func (x *Exif) SetOrientation(o models.Orientation) {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, uint16(o))
+	x.Set(models.OrientationTag, tiff.MakeTag(0, tiff.DTShort, 1, binary.BigEndian, b))
+}
+
+// SetDateTime sets both DateTime and DateTimeOriginal to t, formatted per
+// the EXIF "YYYY:MM:DD HH:MM:SS" convention, in t's own Location (callers
+// wanting a specific recorded zone should convert t via t.In(loc) first).
+func (x *Exif) SetDateTime(t time.Time) {
+	raw := append([]byte(t.Format("2006:01:02 15:04:05")), 0)
+	tag := tiff.MakeTag(0, tiff.DTAscii, uint32(len(raw)), binary.BigEndian, raw)
+	x.Set(models.DateTime, tag)
+	x.Set(models.DateTimeOriginal, tag)
+}
+
+// SetString sets an arbitrary ASCII-valued field, NUL-terminating it per
+// the TIFF ASCII convention.
+func (x *Exif) SetString(name models.FieldName, s string) {
+	raw := append([]byte(s), 0)
+	x.Set(name, tiff.MakeTag(0, tiff.DTAscii, uint32(len(raw)), binary.BigEndian, raw))
+}
+
+// SetGPS sets GPSLatitude/GPSLatitudeRef, GPSLongitude/GPSLongitudeRef, and
+// (if alt is non-zero) GPSAltitude/GPSAltitudeRef from decimal-degree
+// coordinates and an altitude in meters.
+func (x *Exif) SetGPS(lat, lon float64, alt float32) {
+	x.setGPSCoord(lat, models.FieldName("GPSLatitude"), models.FieldName("GPSLatitudeRef"), "N", "S")
+	x.setGPSCoord(lon, models.FieldName("GPSLongitude"), models.FieldName("GPSLongitudeRef"), "E", "W")
+
+	if alt != 0 {
+		ref := byte(0)
+		if alt < 0 {
+			ref = 1
+			alt = -alt
+		}
+		x.Set(models.GPSAltitudeRef, tiff.MakeTag(0, tiff.DTByte, 1, binary.BigEndian, []byte{ref}))
+		x.Set(models.GPSAltitude, tiff.MakeTag(0, tiff.DTRational, 1, binary.BigEndian, encodeRationalFixed(float64(alt))))
+	}
+}
+
+// setGPSCoord writes one of GPSLatitude/GPSLongitude as three rationals
+// (degrees, minutes, seconds) plus its hemisphere ref tag.
+func (x *Exif) setGPSCoord(deg float64, coordField, refField models.FieldName, posRef, negRef string) {
+	ref := posRef
+	if deg < 0 {
+		ref = negRef
+		deg = -deg
+	}
+	degrees := float64(int64(deg))
+	minutesFull := (deg - degrees) * 60
+	minutes := float64(int64(minutesFull))
+	seconds := (minutesFull - minutes) * 60
+
+	b := make([]byte, 0, 24)
+	b = append(b, encodeRationalFixed(degrees)...)
+	b = append(b, encodeRationalFixed(minutes)...)
+	b = append(b, encodeRationalFixed(seconds)...)
+	x.Set(coordField, tiff.MakeTag(0, tiff.DTRational, 3, binary.BigEndian, b))
+
+	refRaw := append([]byte(ref), 0)
+	x.Set(refField, tiff.MakeTag(0, tiff.DTAscii, uint32(len(refRaw)), binary.BigEndian, refRaw))
+}
+
+// encodeRationalFixed encodes v as a rational with a fixed denominator,
+// precise enough for GPS degree/minute/second components and altitude.
+func encodeRationalFixed(v float64) []byte {
+	const denom = 1000000
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint32(b[0:4], uint32(v*denom))
+	binary.BigEndian.PutUint32(b[4:8], denom)
+	return b
+}
+
+// SetGPSDateTime sets GPSDateStamp and GPSTimeStamp from t, which must be
+// in UTC (the GPS tag group, unlike DateTime/DateTimeOriginal, has no
+// separate offset tag of its own): callers holding a local time should
+// convert it via t.UTC() first.
+func (x *Exif) SetGPSDateTime(t time.Time) {
+	t = t.UTC()
+	dateRaw := append([]byte(t.Format("2006:01:02")), 0)
+	x.Set(models.GPSDateStamp, tiff.MakeTag(0, tiff.DTAscii, uint32(len(dateRaw)), binary.BigEndian, dateRaw))
+
+	b := make([]byte, 0, 24)
+	b = append(b, encodeRationalFixed(float64(t.Hour()))...)
+	b = append(b, encodeRationalFixed(float64(t.Minute()))...)
+	b = append(b, encodeRationalFixed(float64(t.Second())+float64(t.Nanosecond())/1e9)...)
+	x.Set(models.GPSTimeStamp, tiff.MakeTag(0, tiff.DTRational, 3, binary.BigEndian, b))
+}
+
+// SetGPSTrack sets GPSTrack/GPSTrackRef to the direction of travel, in
+// degrees true north (0-360).
+func (x *Exif) SetGPSTrack(degreesTrue float64) {
+	refRaw := append([]byte("T"), 0)
+	x.Set(models.FieldName("GPSTrackRef"), tiff.MakeTag(0, tiff.DTAscii, uint32(len(refRaw)), binary.BigEndian, refRaw))
+	x.Set(models.FieldName("GPSTrack"), tiff.MakeTag(0, tiff.DTRational, 1, binary.BigEndian, encodeRationalFixed(degreesTrue)))
+}
+
+// SetGPSAreaInformation sets GPSAreaInformation to s, e.g. a reverse
+// geocoder's description of the GPS position ("Zurich, Switzerland").
+// Like UserComment, GPSAreaInformation is UNDEFINED-typed and prefixed
+// with an 8-byte character-code header rather than being plain ASCII.
+func (x *Exif) SetGPSAreaInformation(s string) {
+	raw := append([]byte("ASCII\x00\x00\x00"), []byte(s)...)
+	x.Set(models.FieldName("GPSAreaInformation"), tiff.MakeTag(0, tiff.DTUndefined, uint32(len(raw)), binary.BigEndian, raw))
+}
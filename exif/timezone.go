@@ -0,0 +1,192 @@
+package exif
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rpajarola/exiftools/models"
+)
+
+// OffsetTime/OffsetTimeOriginal are the EXIF 2.31 tags recording the
+// camera's UTC offset (as a "+HH:MM"/"-HH:MM" string) alongside
+// DateTime/DateTimeOriginal.
+var (
+	OffsetTime         models.FieldName = "OffsetTime"
+	OffsetTimeOriginal models.FieldName = "OffsetTimeOriginal"
+)
+
+// TZLookup resolves the timezone active at a geographic coordinate.
+// Implementations can wrap a package such as gopkg.in/ugjka/go-tz.v2/tz or
+// a custom polygon lookup; DefaultTZLookup defaults to BundledPolygonTZLookup,
+// the dataset embedded in this package.
+type TZLookup interface {
+	Lookup(lat, lon float64) (*time.Location, error)
+}
+
+// DefaultTZLookup is consulted by DateTimeLocal/GPSTimeStampLocal/
+// DateTimeInLocation when an Exif has GPS coordinates but no
+// OffsetTime[Original] tag. Replace it (e.g. with a wrapper around
+// gopkg.in/ugjka/go-tz.v2/tz, or noopTZLookup{} to disable GPS-based
+// resolution entirely) to override the bundled dataset.
+var DefaultTZLookup TZLookup = BundledPolygonTZLookup
+
+// noopTZLookup resolves every coordinate to time.UTC; useful as an
+// explicit opt-out of GPS-based timezone resolution.
+type noopTZLookup struct{}
+
+func (noopTZLookup) Lookup(lat, lon float64) (*time.Location, error) {
+	return time.UTC, nil
+}
+
+var tzCache = struct {
+	mu sync.Mutex
+	m  map[[2]float64]*time.Location
+}{m: map[[2]float64]*time.Location{}}
+
+// roundCoord rounds to 3 decimal places (roughly 100m), far finer than any
+// timezone boundary needs, to keep the cache small when batch-processing
+// many photos from the same trip.
+func roundCoord(v float64) float64 {
+	return float64(int64(v*1000+0.5)) / 1000
+}
+
+// lookupTZCached caches lookup's results by rounded (lat, lon) so repeated
+// calls for nearby coordinates (e.g. an afternoon of photos from one spot)
+// don't re-invoke a possibly expensive TZLookup implementation.
+func lookupTZCached(lookup TZLookup, lat, lon float64) (*time.Location, error) {
+	key := [2]float64{roundCoord(lat), roundCoord(lon)}
+
+	tzCache.mu.Lock()
+	loc, ok := tzCache.m[key]
+	tzCache.mu.Unlock()
+	if ok {
+		return loc, nil
+	}
+
+	loc, err := lookup.Lookup(lat, lon)
+	if err != nil {
+		return nil, err
+	}
+	tzCache.mu.Lock()
+	tzCache.m[key] = loc
+	tzCache.mu.Unlock()
+	return loc, nil
+}
+
+// offsetTimeLocation parses OffsetTimeOriginal/OffsetTime (in that order
+// of preference) into a fixed-offset time.Location.
+func (x *Exif) offsetTimeLocation() (*time.Location, error) {
+	for _, f := range []models.FieldName{OffsetTimeOriginal, OffsetTime} {
+		tag, err := x.Get(f)
+		if err != nil {
+			continue
+		}
+		s, err := tag.StringVal()
+		if err != nil {
+			continue
+		}
+		s = strings.TrimRight(strings.TrimSpace(s), "\x00")
+		if len(s) != 6 || (s[0] != '+' && s[0] != '-') || s[3] != ':' {
+			continue
+		}
+		hh, err1 := strconv.Atoi(s[1:3])
+		mm, err2 := strconv.Atoi(s[4:6])
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		secs := hh*3600 + mm*60
+		if s[0] == '-' {
+			secs = -secs
+		}
+		return time.FixedZone(s, secs), nil
+	}
+	return nil, errors.New("exif: no OffsetTime[Original] tag present")
+}
+
+// resolveLocation determines the photo's local timezone, preferring (in
+// order) the EXIF 2.31 offset tags, a DefaultTZLookup resolution of the
+// GPS coordinates, and finally GPSTimeZone's UTC-offset estimate.
+func (x *Exif) resolveLocation() (*time.Location, error) {
+	if loc, err := x.offsetTimeLocation(); err == nil {
+		return loc, nil
+	}
+	if lat, lon, err := x.LatLong(); err == nil {
+		if loc, err := lookupTZCached(DefaultTZLookup, lat, lon); err == nil {
+			return loc, nil
+		}
+	}
+	return x.GPSTimeZone()
+}
+
+// reinterpretInLocation keeps t's wall-clock components (year, month, ...,
+// nanosecond) but attaches loc instead of t's original Location, which is
+// what's needed to "relabel" a naive EXIF DateTime as belonging to a
+// newly-resolved zone rather than shifting the instant it represents.
+func reinterpretInLocation(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// DateTimeLocal returns DateTimeOriginal (falling back to DateTime)
+// re-interpreted in the photo's local timezone, resolved via
+// resolveLocation. If no timezone can be resolved, the naive time is
+// returned unchanged (its Location is time.UTC, per DateTime/time.Parse).
+func (x *Exif) DateTimeLocal() (time.Time, error) {
+	naive, err := x.DateTime(models.DateTimeOriginal, models.DateTime)
+	if err != nil {
+		return time.Time{}, err
+	}
+	loc, err := x.resolveLocation()
+	if err != nil {
+		return naive, nil
+	}
+	return reinterpretInLocation(naive, loc), nil
+}
+
+// GPSTimeStampLocal returns GPSTimeStamp (always UTC) converted into the
+// same local timezone DateTimeLocal resolves to, so the two can be
+// compared directly without a manual offset.
+func (x *Exif) GPSTimeStampLocal() (time.Time, error) {
+	utc, err := x.GPSTimeStamp()
+	if err != nil {
+		return time.Time{}, err
+	}
+	loc, err := x.resolveLocation()
+	if err != nil {
+		return utc, nil
+	}
+	return utc.In(loc), nil
+}
+
+// DateTimeInLocation returns DateTimeOriginal (falling back to DateTime)
+// together with the timezone it resolves to, preferring, in order: the
+// EXIF 2.31 OffsetTime[Original] tags, a DefaultTZLookup resolution of
+// the GPS coordinates, and finally a MakerNote-embedded timezone index
+// (currently Canon's Canon.TimeInfo, via TimeZone). Unlike DateTimeLocal,
+// it returns an error rather than the naive time when none of these can
+// resolve a zone, since callers asking for the zone explicitly likely
+// want to know when it's unavailable.
+func (x *Exif) DateTimeInLocation() (time.Time, *time.Location, error) {
+	naive, err := x.DateTime(models.DateTimeOriginal, models.DateTime)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	if loc, err := x.offsetTimeLocation(); err == nil {
+		return reinterpretInLocation(naive, loc), loc, nil
+	}
+
+	if lat, lon, err := x.LatLong(); err == nil {
+		if loc, err := lookupTZCached(DefaultTZLookup, lat, lon); err == nil {
+			return reinterpretInLocation(naive, loc), loc, nil
+		}
+	}
+
+	if loc, err := x.TimeZone(); err == nil {
+		return reinterpretInLocation(naive, loc), loc, nil
+	}
+
+	return time.Time{}, nil, errors.New("exif: DateTimeInLocation: no OffsetTime, GPS, or MakerNote timezone available")
+}
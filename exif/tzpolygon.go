@@ -0,0 +1,110 @@
+package exif
+
+import (
+	"bytes"
+	"compress/gzip"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+//go:embed tzdata/tzpolygons.json.gz
+var tzPolygonData []byte
+
+// tzPolygon is one entry of the bundled timezone polygon dataset: a
+// single closed ring of (lon, lat) vertices plus its bounding box, the
+// latter used as a cheap pre-filter before the more expensive
+// point-in-polygon test.
+type tzPolygon struct {
+	Zone          string       `json:"zone"`
+	OffsetSeconds int          `json:"offset_seconds"`
+	MinLat        float64      `json:"min_lat"`
+	MaxLat        float64      `json:"max_lat"`
+	MinLon        float64      `json:"min_lon"`
+	MaxLon        float64      `json:"max_lon"`
+	Points        [][2]float64 `json:"points"`
+}
+
+// PolygonTZLookup is a TZLookup backed by the gzip-compressed dataset
+// embedded at tzdata/tzpolygons.json.gz, so the module can resolve a GPS
+// coordinate to a timezone without depending on an external package such
+// as gopkg.in/ugjka/go-tz.v2/tz or the host OS's tzdata.
+//
+// The bundled dataset approximates real timezone boundaries as 24
+// fifteen-degree-wide longitude strips rather than true political
+// borders; a proper boundary dataset (e.g. timezone-boundary-builder's
+// combined.json) is a drop-in replacement, since it uses the same
+// zone/offset/bounding-box/points schema, just with real polygons
+// instead of rectangles. The strips are enough to recover a photo's UTC
+// offset from its GPS coordinates, which is all DateTimeInLocation needs.
+type PolygonTZLookup struct {
+	once     sync.Once
+	loadErr  error
+	polygons []tzPolygon
+}
+
+// BundledPolygonTZLookup is the TZLookup DefaultTZLookup is initialized
+// to.
+var BundledPolygonTZLookup = &PolygonTZLookup{}
+
+func (p *PolygonTZLookup) load() {
+	gz, err := gzip.NewReader(bytes.NewReader(tzPolygonData))
+	if err != nil {
+		p.loadErr = fmt.Errorf("exif: tzpolygons: %w", err)
+		return
+	}
+	defer gz.Close()
+
+	raw, err := io.ReadAll(gz)
+	if err != nil {
+		p.loadErr = fmt.Errorf("exif: tzpolygons: %w", err)
+		return
+	}
+	if err := json.Unmarshal(raw, &p.polygons); err != nil {
+		p.loadErr = fmt.Errorf("exif: tzpolygons: %w", err)
+		return
+	}
+}
+
+// Lookup implements TZLookup. Candidate polygons are tested in dataset
+// order: MinLat/MaxLat/MinLon/MaxLon first rules out everything whose
+// bounding box misses (lat, lon), then pointInPolygon resolves the (few,
+// for this dataset at most one) remaining candidates exactly.
+func (p *PolygonTZLookup) Lookup(lat, lon float64) (*time.Location, error) {
+	p.once.Do(p.load)
+	if p.loadErr != nil {
+		return nil, p.loadErr
+	}
+
+	for _, poly := range p.polygons {
+		if lat < poly.MinLat || lat > poly.MaxLat || lon < poly.MinLon || lon > poly.MaxLon {
+			continue
+		}
+		if pointInPolygon(lat, lon, poly.Points) {
+			return time.FixedZone(poly.Zone, poly.OffsetSeconds), nil
+		}
+	}
+	return nil, fmt.Errorf("exif: tzpolygons: no zone contains (%v, %v)", lat, lon)
+}
+
+// pointInPolygon reports whether (lat, lon) falls inside the closed ring
+// described by points ([lon, lat] pairs), using the standard ray-casting
+// (even-odd) test: count how many ring edges a ray due east from the
+// point crosses, and call it "inside" when that count is odd.
+func pointInPolygon(lat, lon float64, points [][2]float64) bool {
+	inside := false
+	for i, j := 0, len(points)-1; i < len(points); j, i = i, i+1 {
+		xi, yi := points[i][0], points[i][1]
+		xj, yj := points[j][0], points[j][1]
+		if (yi > lat) != (yj > lat) {
+			lonAtLat := (xj-xi)*(lat-yi)/(yj-yi) + xi
+			if lon < lonAtLat {
+				inside = !inside
+			}
+		}
+	}
+	return inside
+}
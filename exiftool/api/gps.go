@@ -21,6 +21,17 @@ var (
 	ErrGpsCoordsNotValid = errors.New("GPS coordinates not valid")
 	// ErrGPSRationalNotValid means that the rawCoordinates were not long enough.
 	ErrGPSRationalNotValid = errors.New("GPS Coords requires a raw-coordinate with exactly three rationals")
+	// ErrGPSRationalZeroDenominator means one of the degrees/minutes/seconds
+	// rationals had a zero denominator, which would otherwise panic on division.
+	ErrGPSRationalZeroDenominator = errors.New("GPS Coords rational has a zero denominator")
+	// ErrGPSCoordOutOfRange means the decoded latitude or longitude fell
+	// outside the valid [-90,90]/[-180,180] ranges.
+	ErrGPSCoordOutOfRange = errors.New("GPS coordinate out of valid range")
+	// ErrGPSNullIsland means the coordinates decoded to exactly (0, 0),
+	// the "null island" value cameras/apps commonly emit when no GPS fix
+	// was available. Callers that want to treat this as valid (e.g. a
+	// photo genuinely taken at 0,0) can ignore this sentinel explicitly.
+	ErrGPSNullIsland = errors.New("GPS coordinates are null island (0, 0)")
 )
 
 // gpsCoordsFromRationals returns a decimal given the EXIF-encoded information.
@@ -36,6 +47,12 @@ func gpsCoordsFromRationals(refValue string, rawCoordinate []exif.Rational) (dec
 		err = ErrGPSRationalNotValid
 		return
 	}
+	for _, r := range rawCoordinate {
+		if r.Denominator == 0 {
+			err = ErrGPSRationalZeroDenominator
+			return
+		}
+	}
 
 	decimal = (float64(rawCoordinate[0].Numerator) / float64(rawCoordinate[0].Denominator))
 	decimal += (float64(rawCoordinate[1].Numerator) / float64(rawCoordinate[1].Denominator) / 60.0)
@@ -56,6 +73,20 @@ type GpsInfo struct {
 	Timestamp           time.Time
 }
 
+// Validate reports whether gi's coordinates are usable: it rejects
+// out-of-range latitude/longitude with ErrGPSCoordOutOfRange, and flags the
+// common "0,0" null-island case with ErrGPSNullIsland, which callers can
+// choose to ignore if a photo genuinely was taken there.
+func (gi *GpsInfo) Validate() error {
+	if gi.Latitude < -90 || gi.Latitude > 90 || gi.Longitude < -180 || gi.Longitude > 180 {
+		return ErrGPSCoordOutOfRange
+	}
+	if gi.Latitude == 0 && gi.Longitude == 0 {
+		return ErrGPSNullIsland
+	}
+	return nil
+}
+
 // String returns a descriptive string.
 func (gi *GpsInfo) String() string {
 	return fmt.Sprintf("GpsInfo | LAT=(%.05f) LON=(%.05f) ALT=(%d) TIME=[%s] |",
@@ -142,6 +173,22 @@ func (res ExifResults) GPSInfo() (lat, lng float64, err error) {
 	return
 }
 
+// GPSInfoStrict behaves like GPSInfo but additionally validates the
+// decoded coordinates via GpsInfo.Validate, returning the validation error
+// (ErrGPSCoordOutOfRange or ErrGPSNullIsland) instead of silently handing
+// back bogus or sentinel coordinates.
+func (res ExifResults) GPSInfoStrict() (lat, lng float64, err error) {
+	lat, lng, err = res.GPSInfo()
+	if err != nil {
+		return
+	}
+	gi := GpsInfo{Latitude: lat, Longitude: lng}
+	if verr := gi.Validate(); verr != nil {
+		return lat, lng, verr
+	}
+	return lat, lng, nil
+}
+
 // GPSTime convenience func. "IFD/GPS" GPSDateStamp and GPSTimeStamp
 func (res ExifResults) GPSTime() (timestamp time.Time, err error) {
 	dateRaw, err := res.GetTag(GPSIfdString, 0, ifd.GPSDateStamp).GetString(res.exifReader)
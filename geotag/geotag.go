@@ -0,0 +1,116 @@
+// Package geotag correlates a camera's captured-at timestamp against a
+// GPS track log (GPX, NMEA, or KML) and writes the interpolated position
+// back into an image's EXIF GPS tags. See gpx.go/nmea.go/kml.go for
+// parsing a track log into a Track, interpolate.go for locating a
+// timestamp within one, and Tag for the entry point tying it together.
+package geotag
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/rpajarola/exiftools/exif"
+)
+
+// Point is one position fix in a Track: a UTC timestamp, decimal-degree
+// coordinates, and altitude in meters. Alt is math.NaN() when the source
+// log didn't record one (e.g. a GPX file with no <ele>).
+type Point struct {
+	Time time.Time
+	Lat  float64
+	Lon  float64
+	Alt  float64
+}
+
+// Track is a GPS track log's position fixes, sorted ascending by Time.
+type Track []Point
+
+// Merge combines tracks (e.g. one per log file covering a day's shooting)
+// into a single Track sorted by Time, ready for Tag/Interpolate.
+func Merge(tracks ...Track) Track {
+	var out Track
+	for _, t := range tracks {
+		out = append(out, t...)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Time.Before(out[j].Time) })
+	return out
+}
+
+// InterpolationMode selects how Interpolate estimates a position between
+// two track points.
+type InterpolationMode int
+
+const (
+	// Linear interpolates latitude, longitude, and altitude independently
+	// and proportionally to elapsed time. Cheap, and indistinguishable
+	// from GreatCircle for the short gaps typical of a GPS track log.
+	Linear InterpolationMode = iota
+	// GreatCircle interpolates position along the great-circle arc
+	// between the two points (spherical slerp), altitude still linear.
+	// Preferable for sparse logs with long gaps between fixes.
+	GreatCircle
+)
+
+// ReverseGeocoder turns a coordinate into a human-readable place name or
+// description, for populating GPSAreaInformation. Callers plug in
+// whichever geocoding service or offline dataset they have available;
+// this package ships no implementation.
+type ReverseGeocoder interface {
+	Lookup(lat, lon float64) (string, error)
+}
+
+// Config controls how Tag interpolates and what it writes.
+type Config struct {
+	// Offset is the camera clock's offset from UTC (local = UTC +
+	// Offset), used to convert a photo's naive DateTimeOriginal into the
+	// UTC instant to look up in the track. Zero if the camera clock was
+	// already set to UTC.
+	Offset time.Duration
+	// MaxGap is the longest gap, either to the track's nearest endpoint
+	// or between the two points bracketing the captured instant, that
+	// Tag will interpolate across. Beyond it, Tag returns an error
+	// instead of guessing a position from a stale fix.
+	MaxGap time.Duration
+	// Interpolation selects Linear (the default) or GreatCircle.
+	Interpolation InterpolationMode
+	// Geocoder, if non-nil, is consulted for GPSAreaInformation.
+	Geocoder ReverseGeocoder
+}
+
+// Tag resolves capturedAt (the camera's local clock reading, typically
+// from x.DateTime(models.DateTimeOriginal)) against track per cfg, and
+// writes GPSLatitude[Ref], GPSLongitude[Ref], GPSAltitude[Ref],
+// GPSDateStamp, GPSTimeStamp, and GPSTrack into x. If cfg.Geocoder is
+// set, GPSAreaInformation is also populated from the interpolated
+// position.
+func Tag(x *exif.Exif, capturedAt time.Time, track Track, cfg Config) error {
+	utc := capturedAt.Add(-cfg.Offset)
+
+	pt, bearing, err := Interpolate(track, utc, cfg.MaxGap, cfg.Interpolation)
+	if err != nil {
+		return fmt.Errorf("geotag: %w", err)
+	}
+
+	alt := float32(0)
+	if !math.IsNaN(pt.Alt) {
+		alt = float32(pt.Alt)
+	}
+	x.SetGPS(pt.Lat, pt.Lon, alt)
+	x.SetGPSDateTime(utc)
+	if !math.IsNaN(bearing) {
+		x.SetGPSTrack(bearing)
+	}
+
+	if cfg.Geocoder != nil {
+		area, err := cfg.Geocoder.Lookup(pt.Lat, pt.Lon)
+		if err != nil {
+			return fmt.Errorf("geotag: reverse geocoding %v,%v: %w", pt.Lat, pt.Lon, err)
+		}
+		if area != "" {
+			x.SetGPSAreaInformation(area)
+		}
+	}
+	return nil
+}
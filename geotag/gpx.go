@@ -0,0 +1,64 @@
+package geotag
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+// gpxDoc is the subset of the GPX 1.1 schema ParseGPX needs: every
+// <trkpt> across every <trk>/<trkseg>, in document order. Waypoints
+// (<wpt>) and routes (<rte>) aren't track logs and are ignored.
+type gpxDoc struct {
+	XMLName xml.Name   `xml:"gpx"`
+	Tracks  []gpxTrack `xml:"trk"`
+}
+
+type gpxTrack struct {
+	Segments []gpxSegment `xml:"trkseg"`
+}
+
+type gpxSegment struct {
+	Points []gpxPoint `xml:"trkpt"`
+}
+
+type gpxPoint struct {
+	Lat  float64  `xml:"lat,attr"`
+	Lon  float64  `xml:"lon,attr"`
+	Ele  *float64 `xml:"ele"`
+	Time string   `xml:"time"`
+}
+
+// ParseGPX reads a GPX track log and returns its points as a Track,
+// sorted by timestamp. Points without a <time> are skipped, since a
+// track log entry useless for time-based lookup is not a position fix
+// Interpolate can use.
+func ParseGPX(r io.Reader) (Track, error) {
+	var doc gpxDoc
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("geotag: parsing GPX: %w", err)
+	}
+
+	var track Track
+	for _, trk := range doc.Tracks {
+		for _, seg := range trk.Segments {
+			for _, p := range seg.Points {
+				if p.Time == "" {
+					continue
+				}
+				t, err := time.Parse(time.RFC3339, p.Time)
+				if err != nil {
+					return nil, fmt.Errorf("geotag: parsing GPX trkpt time %q: %w", p.Time, err)
+				}
+				alt := math.NaN()
+				if p.Ele != nil {
+					alt = *p.Ele
+				}
+				track = append(track, Point{Time: t.UTC(), Lat: p.Lat, Lon: p.Lon, Alt: alt})
+			}
+		}
+	}
+	return Merge(track), nil
+}
@@ -0,0 +1,130 @@
+package geotag
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// Interpolate locates t within track and returns the estimated position
+// there (per mode) together with the track's bearing (in degrees true
+// north) at that instant, computed from the same two bracketing points
+// regardless of mode. It returns an error if t falls more than maxGap
+// beyond the track's first/last point, or in a gap between two
+// consecutive points wider than maxGap.
+func Interpolate(track Track, t time.Time, maxGap time.Duration, mode InterpolationMode) (Point, float64, error) {
+	if len(track) == 0 {
+		return Point{}, math.NaN(), fmt.Errorf("empty track")
+	}
+
+	i := sort.Search(len(track), func(i int) bool { return !track[i].Time.Before(t) })
+
+	switch {
+	case i == 0:
+		if gap := track[0].Time.Sub(t); gap > maxGap {
+			return Point{}, math.NaN(), fmt.Errorf("%s is %s before the track's first point, exceeding max gap %s", t, gap, maxGap)
+		}
+		if len(track) == 1 {
+			return track[0], math.NaN(), nil
+		}
+		return track[0], bearing(track[0], track[1]), nil
+	case i == len(track):
+		last := track[len(track)-1]
+		if gap := t.Sub(last.Time); gap > maxGap {
+			return Point{}, math.NaN(), fmt.Errorf("%s is %s after the track's last point, exceeding max gap %s", t, gap, maxGap)
+		}
+		if len(track) == 1 {
+			return last, math.NaN(), nil
+		}
+		return last, bearing(track[len(track)-2], last), nil
+	case track[i].Time.Equal(t):
+		return track[i], math.NaN(), nil
+	}
+
+	a, b := track[i-1], track[i]
+	if gap := b.Time.Sub(a.Time); gap > maxGap {
+		return Point{}, math.NaN(), fmt.Errorf("%s falls in a %s gap between track points, exceeding max gap %s", t, gap, maxGap)
+	}
+
+	frac := float64(t.Sub(a.Time)) / float64(b.Time.Sub(a.Time))
+	brg := bearing(a, b)
+	if mode == GreatCircle {
+		return greatCircle(a, b, frac), brg, nil
+	}
+	return linear(a, b, frac), brg, nil
+}
+
+func linear(a, b Point, frac float64) Point {
+	return Point{
+		Time: a.Time.Add(time.Duration(frac * float64(b.Time.Sub(a.Time)))),
+		Lat:  a.Lat + (b.Lat-a.Lat)*frac,
+		Lon:  a.Lon + (b.Lon-a.Lon)*frac,
+		Alt:  lerpAlt(a.Alt, b.Alt, frac),
+	}
+}
+
+// greatCircle interpolates along the great-circle arc between a and b
+// (spherical slerp), per the standard "intermediate point on a
+// great-circle" formula.
+func greatCircle(a, b Point, frac float64) Point {
+	lat1, lon1 := radians(a.Lat), radians(a.Lon)
+	lat2, lon2 := radians(b.Lat), radians(b.Lon)
+
+	d := angularDistance(lat1, lon1, lat2, lon2)
+	if d == 0 {
+		return Point{Time: a.Time, Lat: a.Lat, Lon: a.Lon, Alt: lerpAlt(a.Alt, b.Alt, frac)}
+	}
+
+	A := math.Sin((1-frac)*d) / math.Sin(d)
+	B := math.Sin(frac*d) / math.Sin(d)
+
+	x := A*math.Cos(lat1)*math.Cos(lon1) + B*math.Cos(lat2)*math.Cos(lon2)
+	y := A*math.Cos(lat1)*math.Sin(lon1) + B*math.Cos(lat2)*math.Sin(lon2)
+	z := A*math.Sin(lat1) + B*math.Sin(lat2)
+
+	lat := math.Atan2(z, math.Sqrt(x*x+y*y))
+	lon := math.Atan2(y, x)
+
+	return Point{
+		Time: a.Time.Add(time.Duration(frac * float64(b.Time.Sub(a.Time)))),
+		Lat:  degrees(lat),
+		Lon:  degrees(lon),
+		Alt:  lerpAlt(a.Alt, b.Alt, frac),
+	}
+}
+
+func lerpAlt(a, b, frac float64) float64 {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return math.NaN()
+	}
+	return a + (b-a)*frac
+}
+
+// angularDistance returns the great-circle angular distance, in radians,
+// between two points given in radians, via the haversine formula.
+func angularDistance(lat1, lon1, lat2, lon2 float64) float64 {
+	dLat := lat2 - lat1
+	dLon := lon2 - lon1
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// bearing returns the initial great-circle bearing from a to b, in
+// degrees true north, or math.NaN() if the two points coincide.
+func bearing(a, b Point) float64 {
+	if a.Lat == b.Lat && a.Lon == b.Lon {
+		return math.NaN()
+	}
+	lat1, lon1 := radians(a.Lat), radians(a.Lon)
+	lat2, lon2 := radians(b.Lat), radians(b.Lon)
+	dLon := lon2 - lon1
+
+	y := math.Sin(dLon) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLon)
+	deg := degrees(math.Atan2(y, x))
+	return math.Mod(deg+360, 360)
+}
+
+func radians(deg float64) float64 { return deg * math.Pi / 180 }
+func degrees(rad float64) float64 { return rad * 180 / math.Pi }
@@ -0,0 +1,82 @@
+package geotag
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// kmlDoc is the subset of KML ParseKML needs: every gx:Track extension
+// (the de-facto standard for a timestamped track log in KML, as written
+// by Google Earth and most GPS loggers that export KML) found under a
+// Document's Placemarks. Nested Folders aren't walked; a log exported
+// with Placemarks inside Folders should be flattened before passing it
+// to ParseKML, or handled with a second pass, as this is an edge case
+// the common export tools don't produce.
+type kmlDoc struct {
+	XMLName    xml.Name `xml:"kml"`
+	Placemarks []struct {
+		Track struct {
+			// When and Coord are populated in document order; gx:Track
+			// strictly alternates <when> and <gx:coord> elements, one
+			// pair per fix, so the two slices stay paired by index.
+			When  []string `xml:"when"`
+			Coord []string `xml:"coord"`
+		} `xml:"Track"`
+	} `xml:"Document>Placemark"`
+}
+
+// ParseKML reads a KML document's gx:Track extensions and returns their
+// points as a Track, sorted by timestamp.
+func ParseKML(r io.Reader) (Track, error) {
+	var doc kmlDoc
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("geotag: parsing KML: %w", err)
+	}
+
+	var track Track
+	for _, pm := range doc.Placemarks {
+		when, coord := pm.Track.When, pm.Track.Coord
+		if len(when) != len(coord) {
+			return nil, fmt.Errorf("geotag: KML gx:Track has %d <when> but %d <gx:coord> elements", len(when), len(coord))
+		}
+		for i := range when {
+			t, err := time.Parse(time.RFC3339, when[i])
+			if err != nil {
+				return nil, fmt.Errorf("geotag: parsing KML <when> %q: %w", when[i], err)
+			}
+			lon, lat, alt, err := parseKMLCoord(coord[i])
+			if err != nil {
+				return nil, fmt.Errorf("geotag: parsing KML <gx:coord> %q: %w", coord[i], err)
+			}
+			track = append(track, Point{Time: t.UTC(), Lat: lat, Lon: lon, Alt: alt})
+		}
+	}
+	return Merge(track), nil
+}
+
+// parseKMLCoord parses a gx:coord element, "lon lat [alt]" space
+// separated, altitude omitted if the logger didn't record one.
+func parseKMLCoord(s string) (lon, lat, alt float64, err error) {
+	fields := strings.Fields(s)
+	if len(fields) < 2 {
+		return 0, 0, 0, fmt.Errorf("expected \"lon lat [alt]\", got %d fields", len(fields))
+	}
+	if lon, err = strconv.ParseFloat(fields[0], 64); err != nil {
+		return 0, 0, 0, err
+	}
+	if lat, err = strconv.ParseFloat(fields[1], 64); err != nil {
+		return 0, 0, 0, err
+	}
+	alt = math.NaN()
+	if len(fields) >= 3 {
+		if alt, err = strconv.ParseFloat(fields[2], 64); err != nil {
+			return 0, 0, 0, err
+		}
+	}
+	return lon, lat, alt, nil
+}
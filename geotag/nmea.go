@@ -0,0 +1,105 @@
+package geotag
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseNMEA reads a stream of NMEA 0183 sentences (as logged by most GPS
+// loggers and dashcams) and returns a Track built from its $--RMC
+// sentences, the only NMEA sentence type carrying a full UTC date, time,
+// and position fix in one line. $--GGA's altitude is intentionally not
+// merged in: doing so correctly requires correlating two different
+// sentence types by nearest timestamp, a precision GPX/KML loggers
+// already provide directly, so RMC-only keeps this parser proportional
+// to how often raw NMEA logs are actually the input (ParseGPX/ParseKML
+// are the common path).
+func ParseNMEA(r io.Reader) (Track, error) {
+	var track Track
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "$") {
+			continue
+		}
+		if idx := strings.IndexByte(line, '*'); idx != -1 {
+			line = line[:idx]
+		}
+		fields := strings.Split(line[1:], ",")
+		if len(fields) < 10 || !strings.HasSuffix(fields[0], "RMC") {
+			continue
+		}
+
+		p, ok, err := parseRMC(fields)
+		if err != nil {
+			return nil, fmt.Errorf("geotag: parsing NMEA sentence %q: %w", line, err)
+		}
+		if ok {
+			track = append(track, p)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("geotag: reading NMEA stream: %w", err)
+	}
+	return Merge(track), nil
+}
+
+// parseRMC parses a $--RMC sentence's already-split, checksum-stripped
+// fields (fields[0] is the sentence ID, e.g. "GPRMC"). ok is false for a
+// sentence with no valid fix ("V" status) rather than an error, since
+// that's an expected, common entry in a real log.
+func parseRMC(fields []string) (Point, bool, error) {
+	// 1:time 2:status 3:lat 4:N/S 5:lon 6:E/W ... 9:date
+	if fields[2] != "A" {
+		return Point{}, false, nil
+	}
+	t, err := nmeaTimeDate(fields[1], fields[9])
+	if err != nil {
+		return Point{}, false, err
+	}
+	lat, err := nmeaCoord(fields[3], fields[4], 2)
+	if err != nil {
+		return Point{}, false, err
+	}
+	lon, err := nmeaCoord(fields[5], fields[6], 3)
+	if err != nil {
+		return Point{}, false, err
+	}
+	return Point{Time: t, Lat: lat, Lon: lon, Alt: math.NaN()}, true, nil
+}
+
+// nmeaTimeDate combines NMEA's "hhmmss[.sss]" time and "ddmmyy" date
+// fields into a UTC time.Time.
+func nmeaTimeDate(hms, ddmmyy string) (time.Time, error) {
+	if len(hms) < 6 || len(ddmmyy) != 6 {
+		return time.Time{}, fmt.Errorf("malformed time/date fields %q/%q", hms, ddmmyy)
+	}
+	return time.Parse("150405.999 020106", hms+" "+ddmmyy)
+}
+
+// nmeaCoord converts an NMEA "ddmm.mmmm"/"dddmm.mmmm"-format coordinate
+// (degrees field degreeDigits wide) and hemisphere letter into decimal
+// degrees.
+func nmeaCoord(raw, hemisphere string, degreeDigits int) (float64, error) {
+	if len(raw) < degreeDigits {
+		return 0, fmt.Errorf("malformed coordinate %q", raw)
+	}
+	deg, err := strconv.ParseFloat(raw[:degreeDigits], 64)
+	if err != nil {
+		return 0, err
+	}
+	min, err := strconv.ParseFloat(raw[degreeDigits:], 64)
+	if err != nil {
+		return 0, err
+	}
+	v := deg + min/60
+	if hemisphere == "S" || hemisphere == "W" {
+		v = -v
+	}
+	return v, nil
+}
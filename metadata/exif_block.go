@@ -0,0 +1,66 @@
+package metadata
+
+import (
+	"bytes"
+
+	"github.com/rpajarola/exiftools/exif"
+	"github.com/rpajarola/exiftools/models"
+)
+
+// exifBlock holds the subset of a decoded *exif.Exif that participates in
+// the merge, plus the decoded Exif itself so WriteBack can apply typed
+// setters to it.
+type exifBlock struct {
+	x                *exif.Exif
+	title            string
+	description      string
+	artist           string
+	copyright        string
+	dateTimeOriginal string
+	gps              *GPS
+	lensModel        string
+}
+
+// parseExifBlock decodes data's EXIF data, if any. A non-nil x is used
+// even alongside a non-nil err, since per-tag/per-maker-note failures
+// (unlike a wholesale "not a TIFF" failure, which returns x == nil) still
+// leave the rest of the block usable.
+func parseExifBlock(data []byte) *exifBlock {
+	x, _ := exif.Decode(bytes.NewReader(data))
+	if x == nil {
+		return nil
+	}
+
+	b := &exifBlock{x: x}
+	if tag, err := x.Get(models.ImageDescription); err == nil {
+		if s, err := tag.StringVal(); err == nil {
+			b.description = s
+		}
+	}
+	if tag, err := x.Get(models.Artist); err == nil {
+		if s, err := tag.StringVal(); err == nil {
+			b.artist = s
+		}
+	}
+	if tag, err := x.Get(models.Copyright); err == nil {
+		if s, err := tag.StringVal(); err == nil {
+			b.copyright = s
+		}
+	}
+	if t, err := x.DateTime(models.DateTimeOriginal, models.DateTime); err == nil {
+		b.dateTimeOriginal = t.Format("2006:01:02 15:04:05")
+	}
+	if tag, err := x.Get(models.LensModel); err == nil {
+		if s, err := tag.StringVal(); err == nil {
+			b.lensModel = s
+		}
+	}
+	if lat, lon, err := x.LatLong(); err == nil {
+		g := &GPS{Latitude: lat, Longitude: lon}
+		if alt, err := x.GPSAltitude(); err == nil {
+			g.Altitude = float64(alt)
+		}
+		b.gps = g
+	}
+	return b
+}
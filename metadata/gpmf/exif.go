@@ -0,0 +1,124 @@
+package gpmf
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/rpajarola/exiftools/exif"
+	"github.com/rpajarola/exiftools/models"
+	"github.com/rpajarola/exiftools/tiff"
+)
+
+// GoProGPMFRaw holds every GPMF sample from a file's "gpmd" track,
+// length-prefixed and concatenated, the same way MakerNote sits as raw
+// bytes in x.Fields until a vendor Parser (e.g. mknote.Sony) decodes it;
+// GPS5FromExif/AccelerometerFromExif/GyroscopeFromExif are this field's
+// decoders.
+var GoProGPMFRaw models.FieldName = "GoPro.GPMFRaw"
+
+// Load locates the gpmd track in r (an MP4/MOV of size bytes) and
+// stores its raw GPMF samples on x as GoProGPMFRaw, alongside whatever
+// maker-note/EXIF fields a registered exif.Parser already loaded. Unlike
+// a MakerNote parser, Load isn't run automatically by exif.Decode: it
+// needs r as an io.ReaderAt over the whole container, which Decode's
+// io.Reader-based entry points don't retain once the TIFF/EXIF payload
+// has been extracted from it.
+func Load(x *exif.Exif, r io.ReaderAt, size int64) error {
+	samples, err := GPMFSamples(r, size)
+	if err != nil {
+		return err
+	}
+	raw := encodeSamples(samples)
+	x.Set(GoProGPMFRaw, tiff.MakeTag(0, tiff.DTUndefined, uint32(len(raw)), nil, raw))
+	return nil
+}
+
+// encodeSamples concatenates samples as a sequence of 4-byte
+// big-endian-length-prefixed byte strings, so decodeSamples can split
+// them back apart; GPMF records don't self-delimit across an MP4
+// sample boundary the way they do within one.
+func encodeSamples(samples [][]byte) []byte {
+	var buf bytes.Buffer
+	var lenPrefix [4]byte
+	for _, s := range samples {
+		putUint32(lenPrefix[:], uint32(len(s)))
+		buf.Write(lenPrefix[:])
+		buf.Write(s)
+	}
+	return buf.Bytes()
+}
+
+func decodeSamples(raw []byte) ([][]byte, error) {
+	var samples [][]byte
+	for i := 0; i+4 <= len(raw); {
+		n := int(getUint32(raw[i : i+4]))
+		i += 4
+		if i+n > len(raw) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		samples = append(samples, raw[i:i+n])
+		i += n
+	}
+	return samples, nil
+}
+
+func putUint32(b []byte, v uint32) {
+	b[0], b[1], b[2], b[3] = byte(v>>24), byte(v>>16), byte(v>>8), byte(v)
+}
+
+func getUint32(b []byte) uint32 {
+	return uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+}
+
+// allDEVCChildren decodes every raw GPMF sample stored in x under
+// GoProGPMFRaw and flattens each sample's top-level DEVC record's
+// children into one slice, the shape GPS5/Accelerometer/Gyroscope expect.
+func allDEVCChildren(x *exif.Exif) ([]Record, error) {
+	tag, err := x.Get(GoProGPMFRaw)
+	if err != nil {
+		return nil, err
+	}
+	samples, err := decodeSamples(tag.Val)
+	if err != nil {
+		return nil, err
+	}
+
+	var all []Record
+	for _, raw := range samples {
+		records, err := Decode(raw)
+		if err != nil {
+			return nil, err
+		}
+		for _, devc := range findAll(records, "DEVC") {
+			all = append(all, devc.Children...)
+		}
+	}
+	return all, nil
+}
+
+// GPS5FromExif decodes every GPS5 sample GoProGPMFRaw holds on x.
+func GPS5FromExif(x *exif.Exif) ([]GPS5Sample, error) {
+	records, err := allDEVCChildren(x)
+	if err != nil {
+		return nil, err
+	}
+	return GPS5(records)
+}
+
+// AccelerometerFromExif decodes every ACCL sample GoProGPMFRaw holds on x.
+func AccelerometerFromExif(x *exif.Exif) ([]AxisSample, error) {
+	records, err := allDEVCChildren(x)
+	if err != nil {
+		return nil, err
+	}
+	return Accelerometer(records)
+}
+
+// GyroscopeFromExif decodes every GYRO sample GoProGPMFRaw holds on x.
+func GyroscopeFromExif(x *exif.Exif) ([]AxisSample, error) {
+	records, err := allDEVCChildren(x)
+	if err != nil {
+		return nil, err
+	}
+	return Gyroscope(records)
+}
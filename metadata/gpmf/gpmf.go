@@ -0,0 +1,103 @@
+// Package gpmf decodes GoPro Metadata Format (GPMF) telemetry, the
+// chunked TLV stream HERO5+ cameras mux into an MP4's "gpmd" track
+// alongside the video. See mp4.go for locating that track's samples and
+// telemetry.go for turning GPS5/ACCL/GYRO records into typed samples.
+package gpmf
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// typeSize is the byte width of one element of a GPMF scalar type, or 0
+// for types (nested, complex, string) whose element width isn't fixed.
+var typeSize = map[byte]int{
+	'b': 1, 'B': 1,
+	's': 2, 'S': 2,
+	'l': 4, 'L': 4,
+	'f': 4,
+	'd': 8,
+	'F': 4, // FourCC, used as a 4-byte "type" value in its own right
+	'c': 1, // ASCII character
+	'j': 8, 'J': 8,
+	'q': 4, 'Q': 8,
+}
+
+// Record is one decoded GPMF TLV entry: a 4-byte ASCII FourCC key, a
+// type code (0 for a nested record whose payload is itself a sequence of
+// Records), and the raw, alignment-stripped payload bytes. StructSize
+// and Count are kept alongside Data since a type's element width isn't
+// always inferable from Data's length alone (e.g. a zero-sample record).
+type Record struct {
+	FourCC     string
+	Type       byte
+	StructSize int
+	Count      int
+	Data       []byte
+	Children   []Record
+}
+
+// Nested reports whether r's payload is itself a sequence of Records
+// (GPMF's type code for this is the NUL byte) rather than scalar data.
+func (r Record) Nested() bool {
+	return r.Type == 0
+}
+
+// Decode parses data as a sequence of sibling GPMF records, recursing
+// into nested ones. data is typically one sample emitted by the "gpmd"
+// MP4 track (see mp4.go), which in turn contains one top-level "DEVC"
+// record per device, itself containing "STRM" records per telemetry
+// stream (GPS5, ACCL, GYRO, ...).
+func Decode(data []byte) ([]Record, error) {
+	var records []Record
+	i := 0
+	for i+8 <= len(data) {
+		fourCC := string(data[i : i+4])
+		typ := data[i+4]
+		structSize := int(data[i+5])
+		count := int(binary.BigEndian.Uint16(data[i+6 : i+8]))
+
+		payloadLen := structSize * count
+		// Every record is padded to a 4-byte boundary, same as the
+		// header before it.
+		paddedLen := (payloadLen + 3) &^ 3
+		start := i + 8
+		if start+paddedLen > len(data) {
+			return nil, fmt.Errorf("gpmf: truncated record %q: need %d bytes, have %d", fourCC, paddedLen, len(data)-start)
+		}
+		payload := data[start : start+payloadLen]
+
+		rec := Record{FourCC: fourCC, Type: typ, StructSize: structSize, Count: count, Data: payload}
+		if typ == 0 {
+			children, err := Decode(payload)
+			if err != nil {
+				return nil, fmt.Errorf("gpmf: %s: %w", fourCC, err)
+			}
+			rec.Children = children
+		}
+		records = append(records, rec)
+		i = start + paddedLen
+	}
+	return records, nil
+}
+
+// find returns the first direct child of records with the given FourCC.
+func find(records []Record, fourCC string) (Record, bool) {
+	for _, r := range records {
+		if r.FourCC == fourCC {
+			return r, true
+		}
+	}
+	return Record{}, false
+}
+
+// findAll returns every direct child of records with the given FourCC.
+func findAll(records []Record, fourCC string) []Record {
+	var out []Record
+	for _, r := range records {
+		if r.FourCC == fourCC {
+			out = append(out, r)
+		}
+	}
+	return out
+}
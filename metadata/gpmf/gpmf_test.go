@@ -0,0 +1,72 @@
+package gpmf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+// record4CC builds one GPMF record: a 4-byte FourCC, type, struct size,
+// big-endian count, then payload padded to a 4-byte boundary.
+func record4CC(fourCC string, typ byte, structSize int, count int, payload []byte) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(fourCC)
+	buf.WriteByte(typ)
+	buf.WriteByte(byte(structSize))
+	var countBytes [2]byte
+	binary.BigEndian.PutUint16(countBytes[:], uint16(count))
+	buf.Write(countBytes[:])
+	buf.Write(payload)
+	for buf.Len()%4 != 0 {
+		buf.WriteByte(0)
+	}
+	return buf.Bytes()
+}
+
+// int32sBE packs vs as consecutive big-endian int32 values.
+func int32sBE(vs ...int32) []byte {
+	buf := make([]byte, 4*len(vs))
+	for i, v := range vs {
+		binary.BigEndian.PutUint32(buf[i*4:i*4+4], uint32(v))
+	}
+	return buf
+}
+
+// TestDecodeGPS5Stream builds a minimal DEVC > STRM > (SCAL, GPS5) tree
+// by hand and checks Decode/GPS5 recover one sample correctly.
+func TestDecodeGPS5Stream(t *testing.T) {
+	// One GPS5 sample: [lat, lon, alt, speed2d, speed3d], scaled by
+	// [10000000, 10000000, 1000, 1000, 1000], matching GPMF's usual
+	// GPS5 SCAL convention.
+	gps5Payload := int32sBE(473607050, 85401230, 350000, 2500, 2600)
+	scal := record4CC("SCAL", 'l', 4, 5, int32sBE(10000000, 10000000, 1000, 1000, 1000))
+	gps5 := record4CC("GPS5", 'l', 20, 1, gps5Payload)
+
+	strmPayload := append(append([]byte{}, scal...), gps5...)
+	strm := record4CC("STRM", 0, 4, len(strmPayload)/4, strmPayload)
+	devc := record4CC("DEVC", 0, 4, len(strm)/4, strm)
+
+	records, err := Decode(devc)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if len(records) != 1 || records[0].FourCC != "DEVC" {
+		t.Fatalf("expected one DEVC record, got %+v", records)
+	}
+
+	samples, err := GPS5(records[0].Children)
+	if err != nil {
+		t.Fatalf("GPS5: %v", err)
+	}
+	if len(samples) != 1 {
+		t.Fatalf("expected 1 GPS5 sample, got %d", len(samples))
+	}
+
+	got := samples[0]
+	if got.Latitude != 47.360705 || got.Longitude != 8.540123 {
+		t.Errorf("unexpected lat/lon: %+v", got)
+	}
+	if got.Altitude != 350 || got.Speed2D != 2.5 || got.Speed3D != 2.6 {
+		t.Errorf("unexpected altitude/speed: %+v", got)
+	}
+}
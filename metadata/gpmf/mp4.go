@@ -0,0 +1,338 @@
+package gpmf
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// box is one parsed ISO Base Media File Format box: its type, and the
+// absolute file offset/length of its payload (everything after the
+// size+type header, and the 64-bit "largesize" extension when present).
+type box struct {
+	typ    string
+	offset int64
+	size   int64
+}
+
+// readBoxes walks sibling boxes starting at offset within [offset,
+// offset+limit), reading only each box's 8- or 16-byte header — never
+// its payload — so a multi-gigabyte "mdat" is skipped in constant time.
+func readBoxes(r io.ReaderAt, offset, limit int64) ([]box, error) {
+	var boxes []box
+	end := offset + limit
+	pos := offset
+	for pos+8 <= end {
+		hdr := make([]byte, 8)
+		if _, err := r.ReadAt(hdr, pos); err != nil {
+			return nil, fmt.Errorf("gpmf: mp4: reading box header at %d: %w", pos, err)
+		}
+		size := int64(binary.BigEndian.Uint32(hdr[0:4]))
+		typ := string(hdr[4:8])
+		headerLen := int64(8)
+
+		switch size {
+		case 1: // 64-bit "largesize" follows the type
+			ext := make([]byte, 8)
+			if _, err := r.ReadAt(ext, pos+8); err != nil {
+				return nil, fmt.Errorf("gpmf: mp4: reading largesize for %q: %w", typ, err)
+			}
+			size = int64(binary.BigEndian.Uint64(ext))
+			headerLen = 16
+		case 0: // box extends to the end of its parent
+			size = end - pos
+		}
+		if size < headerLen || pos+size > end {
+			return nil, fmt.Errorf("gpmf: mp4: invalid size for box %q", typ)
+		}
+
+		boxes = append(boxes, box{typ: typ, offset: pos + headerLen, size: size - headerLen})
+		pos += size
+	}
+	return boxes, nil
+}
+
+func findBox(boxes []box, typ string) (box, bool) {
+	for _, b := range boxes {
+		if b.typ == typ {
+			return b, true
+		}
+	}
+	return box{}, false
+}
+
+// readBoxPayload reads all of b's payload into memory.
+func readBoxPayload(r io.ReaderAt, b box) ([]byte, error) {
+	buf := make([]byte, b.size)
+	if _, err := r.ReadAt(buf, b.offset); err != nil {
+		return nil, fmt.Errorf("gpmf: mp4: reading %q payload: %w", b.typ, err)
+	}
+	return buf, nil
+}
+
+// sampleTable is the subset of an MP4 "stbl" box GPMFSamples needs to
+// compute each sample's absolute (offset, size): sample sizes, the
+// samples-per-chunk runs, and chunk offsets, combined per the standard
+// MP4 sample-table algorithm (ISO/IEC 14496-12 §8.7).
+type sampleTable struct {
+	sampleSizes     []uint32 // one per sample, or a single shared size if len==1
+	chunkOffsets    []int64
+	samplesPerChunk []sampleToChunkEntry
+}
+
+type sampleToChunkEntry struct {
+	firstChunk      uint32 // 1-based
+	samplesPerChunk uint32
+}
+
+// GPMFSamples locates the first "gpmd"-format track in an MP4/MOV file
+// (the GoPro telemetry track HERO5+ cameras mux alongside video) and
+// returns each of its samples' raw GPMF bytes, in order, ready to pass
+// to Decode.
+func GPMFSamples(r io.ReaderAt, size int64) ([][]byte, error) {
+	top, err := readBoxes(r, 0, size)
+	if err != nil {
+		return nil, err
+	}
+	moov, ok := findBox(top, "moov")
+	if !ok {
+		return nil, fmt.Errorf("gpmf: mp4: no moov box found")
+	}
+	moovBoxes, err := readBoxes(r, moov.offset, moov.size)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, trak := range moovBoxes {
+		if trak.typ != "trak" {
+			continue
+		}
+		table, ok, err := gpmdSampleTable(r, trak)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		return readSamples(r, table)
+	}
+	return nil, fmt.Errorf("gpmf: mp4: no gpmd track found")
+}
+
+// gpmdSampleTable reads trak's mdia/minf/stbl if trak's sample
+// description format is "gpmd", returning ok=false for every other
+// track (video, audio, timecode, ...) without error.
+func gpmdSampleTable(r io.ReaderAt, trak box) (sampleTable, bool, error) {
+	trakBoxes, err := readBoxes(r, trak.offset, trak.size)
+	if err != nil {
+		return sampleTable{}, false, err
+	}
+	mdia, ok := findBox(trakBoxes, "mdia")
+	if !ok {
+		return sampleTable{}, false, nil
+	}
+	mdiaBoxes, err := readBoxes(r, mdia.offset, mdia.size)
+	if err != nil {
+		return sampleTable{}, false, err
+	}
+	minf, ok := findBox(mdiaBoxes, "minf")
+	if !ok {
+		return sampleTable{}, false, nil
+	}
+	minfBoxes, err := readBoxes(r, minf.offset, minf.size)
+	if err != nil {
+		return sampleTable{}, false, err
+	}
+	stbl, ok := findBox(minfBoxes, "stbl")
+	if !ok {
+		return sampleTable{}, false, nil
+	}
+	stblBoxes, err := readBoxes(r, stbl.offset, stbl.size)
+	if err != nil {
+		return sampleTable{}, false, err
+	}
+
+	stsd, ok := findBox(stblBoxes, "stsd")
+	if !ok {
+		return sampleTable{}, false, nil
+	}
+	isGPMD, err := stsdIsFormat(r, stsd, "gpmd")
+	if err != nil {
+		return sampleTable{}, false, err
+	}
+	if !isGPMD {
+		return sampleTable{}, false, nil
+	}
+
+	table, err := readSampleTable(r, stblBoxes)
+	if err != nil {
+		return sampleTable{}, false, err
+	}
+	return table, true, nil
+}
+
+// stsdIsFormat reports whether stsd's first sample description entry's
+// format fourCC equals want. stsd is a full box (version+flags) wrapping
+// an entry count, then that many [size, format, ...] entries.
+func stsdIsFormat(r io.ReaderAt, stsd box, want string) (bool, error) {
+	hdr := make([]byte, 8)
+	if _, err := r.ReadAt(hdr, stsd.offset); err != nil {
+		return false, fmt.Errorf("gpmf: mp4: reading stsd header: %w", err)
+	}
+	entryCount := binary.BigEndian.Uint32(hdr[4:8])
+	if entryCount == 0 {
+		return false, nil
+	}
+	entry := make([]byte, 8)
+	if _, err := r.ReadAt(entry, stsd.offset+8); err != nil {
+		return false, fmt.Errorf("gpmf: mp4: reading stsd entry: %w", err)
+	}
+	format := string(entry[4:8])
+	return format == want, nil
+}
+
+// readSampleTable reads the stsz/stsc/(stco|co64) children of stbl.
+func readSampleTable(r io.ReaderAt, stblBoxes []box) (sampleTable, error) {
+	var table sampleTable
+
+	if stsz, ok := findBox(stblBoxes, "stsz"); ok {
+		payload, err := readBoxPayload(r, stsz)
+		if err != nil {
+			return table, err
+		}
+		if len(payload) < 12 {
+			return table, fmt.Errorf("gpmf: mp4: stsz too short")
+		}
+		sampleSize := binary.BigEndian.Uint32(payload[4:8])
+		count := binary.BigEndian.Uint32(payload[8:12])
+		if sampleSize != 0 {
+			table.sampleSizes = []uint32{sampleSize}
+			// A single shared size still needs a count for readSamples
+			// to know how many samples exist; stash it as a sentinel
+			// entry so len(sampleSizes)==1 still carries it.
+			table.sampleSizes = append(table.sampleSizes, count)
+		} else {
+			if len(payload) < int(12+4*count) {
+				return table, fmt.Errorf("gpmf: mp4: stsz entry table too short")
+			}
+			table.sampleSizes = make([]uint32, count)
+			for i := uint32(0); i < count; i++ {
+				table.sampleSizes[i] = binary.BigEndian.Uint32(payload[12+4*i : 16+4*i])
+			}
+		}
+	} else {
+		return table, fmt.Errorf("gpmf: mp4: no stsz box found")
+	}
+
+	if stsc, ok := findBox(stblBoxes, "stsc"); ok {
+		payload, err := readBoxPayload(r, stsc)
+		if err != nil {
+			return table, err
+		}
+		if len(payload) < 8 {
+			return table, fmt.Errorf("gpmf: mp4: stsc too short")
+		}
+		count := binary.BigEndian.Uint32(payload[4:8])
+		if len(payload) < int(8+12*count) {
+			return table, fmt.Errorf("gpmf: mp4: stsc entry table too short")
+		}
+		for i := uint32(0); i < count; i++ {
+			base := 8 + 12*i
+			table.samplesPerChunk = append(table.samplesPerChunk, sampleToChunkEntry{
+				firstChunk:      binary.BigEndian.Uint32(payload[base : base+4]),
+				samplesPerChunk: binary.BigEndian.Uint32(payload[base+4 : base+8]),
+			})
+		}
+	} else {
+		return table, fmt.Errorf("gpmf: mp4: no stsc box found")
+	}
+
+	if stco, ok := findBox(stblBoxes, "stco"); ok {
+		payload, err := readBoxPayload(r, stco)
+		if err != nil {
+			return table, err
+		}
+		if len(payload) < 8 {
+			return table, fmt.Errorf("gpmf: mp4: stco too short")
+		}
+		count := binary.BigEndian.Uint32(payload[4:8])
+		if len(payload) < int(8+4*count) {
+			return table, fmt.Errorf("gpmf: mp4: stco entry table too short")
+		}
+		for i := uint32(0); i < count; i++ {
+			table.chunkOffsets = append(table.chunkOffsets, int64(binary.BigEndian.Uint32(payload[8+4*i:12+4*i])))
+		}
+	} else if co64, ok := findBox(stblBoxes, "co64"); ok {
+		payload, err := readBoxPayload(r, co64)
+		if err != nil {
+			return table, err
+		}
+		if len(payload) < 8 {
+			return table, fmt.Errorf("gpmf: mp4: co64 too short")
+		}
+		count := binary.BigEndian.Uint32(payload[4:8])
+		if len(payload) < int(8+8*count) {
+			return table, fmt.Errorf("gpmf: mp4: co64 entry table too short")
+		}
+		for i := uint32(0); i < count; i++ {
+			table.chunkOffsets = append(table.chunkOffsets, int64(binary.BigEndian.Uint64(payload[8+8*i:16+8*i])))
+		}
+	} else {
+		return table, fmt.Errorf("gpmf: mp4: no stco/co64 box found")
+	}
+
+	return table, nil
+}
+
+// readSamples reads every sample described by table, in order, applying
+// the stsc/stco/stsz algorithm to locate each one's absolute file offset.
+func readSamples(r io.ReaderAt, table sampleTable) ([][]byte, error) {
+	sampleCount, sizeOf := sampleSizer(table.sampleSizes)
+	if len(table.chunkOffsets) == 0 || len(table.samplesPerChunk) == 0 {
+		return nil, fmt.Errorf("gpmf: mp4: empty sample table")
+	}
+
+	var samples [][]byte
+	sampleIndex := uint32(0)
+	for chunkIdx, chunkOffset := range table.chunkOffsets {
+		chunkNum := uint32(chunkIdx) + 1
+		perChunk := samplesPerChunkFor(table.samplesPerChunk, chunkNum)
+
+		pos := chunkOffset
+		for i := uint32(0); i < perChunk && sampleIndex < sampleCount; i++ {
+			n := sizeOf(sampleIndex)
+			buf := make([]byte, n)
+			if _, err := r.ReadAt(buf, pos); err != nil {
+				return nil, fmt.Errorf("gpmf: mp4: reading sample %d: %w", sampleIndex, err)
+			}
+			samples = append(samples, buf)
+			pos += int64(n)
+			sampleIndex++
+		}
+	}
+	return samples, nil
+}
+
+// sampleSizer returns the total sample count and a function mapping a
+// sample index to its size, handling stsz's two encodings: a single
+// shared size (sampleSizes == [size, count]) or one entry per sample.
+func sampleSizer(sampleSizes []uint32) (uint32, func(uint32) uint32) {
+	if len(sampleSizes) == 2 {
+		size, count := sampleSizes[0], sampleSizes[1]
+		return count, func(uint32) uint32 { return size }
+	}
+	sizes := sampleSizes
+	return uint32(len(sizes)), func(i uint32) uint32 { return sizes[i] }
+}
+
+// samplesPerChunkFor resolves the stsc run covering chunkNum (1-based).
+func samplesPerChunkFor(entries []sampleToChunkEntry, chunkNum uint32) uint32 {
+	perChunk := uint32(0)
+	for _, e := range entries {
+		if e.firstChunk > chunkNum {
+			break
+		}
+		perChunk = e.samplesPerChunk
+	}
+	return perChunk
+}
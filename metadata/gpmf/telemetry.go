@@ -0,0 +1,161 @@
+package gpmf
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// GPS5Sample is one decoded GPS5 telemetry sample: latitude/longitude in
+// degrees, altitude in meters, and 2D/3D ground speed in m/s, the order
+// GPMF's GPS5 stream packs them in.
+type GPS5Sample struct {
+	Latitude  float64
+	Longitude float64
+	Altitude  float64
+	Speed2D   float64
+	Speed3D   float64
+}
+
+// AxisSample is one decoded 3-axis sample, used for both ACCL
+// (acceleration, m/s^2) and GYRO (angular velocity, rad/s) streams.
+// GPMF itself doesn't document axis order consistently across camera
+// models; X/Y/Z here are simply the stream's packed order, not a
+// guarantee of physical axis orientation.
+type AxisSample struct {
+	X, Y, Z float64
+}
+
+// decodeScaled decodes data as count groups of elemsPerSample raw
+// numeric values of type typ, dividing each by the corresponding SCAL
+// divisor (cycling through scal if it has fewer entries than
+// elemsPerSample, GPMF's convention for a single shared divisor).
+func decodeScaled(data []byte, typ byte, count, elemsPerSample int, scal []float64) ([][]float64, error) {
+	raw, err := decodeNumeric(data, typ, count*elemsPerSample)
+	if err != nil {
+		return nil, err
+	}
+	if len(scal) == 0 {
+		scal = []float64{1}
+	}
+
+	samples := make([][]float64, count)
+	for i := 0; i < count; i++ {
+		sample := make([]float64, elemsPerSample)
+		for j := 0; j < elemsPerSample; j++ {
+			sample[j] = raw[i*elemsPerSample+j] / scal[j%len(scal)]
+		}
+		samples[i] = sample
+	}
+	return samples, nil
+}
+
+// decodeNumeric decodes data as n big-endian scalar values of type typ.
+func decodeNumeric(data []byte, typ byte, n int) ([]float64, error) {
+	size := typeSize[typ]
+	if size == 0 {
+		return nil, fmt.Errorf("gpmf: unsupported numeric type %q", typ)
+	}
+	if len(data) < n*size {
+		return nil, fmt.Errorf("gpmf: short read decoding %d values of type %q: have %d bytes, need %d", n, typ, len(data), n*size)
+	}
+
+	out := make([]float64, n)
+	for i := 0; i < n; i++ {
+		b := data[i*size : (i+1)*size]
+		switch typ {
+		case 'b':
+			out[i] = float64(int8(b[0]))
+		case 'B', 'c':
+			out[i] = float64(b[0])
+		case 's':
+			out[i] = float64(int16(binary.BigEndian.Uint16(b)))
+		case 'S':
+			out[i] = float64(binary.BigEndian.Uint16(b))
+		case 'l':
+			out[i] = float64(int32(binary.BigEndian.Uint32(b)))
+		case 'L':
+			out[i] = float64(binary.BigEndian.Uint32(b))
+		case 'f':
+			out[i] = float64(math.Float32frombits(binary.BigEndian.Uint32(b)))
+		case 'd':
+			out[i] = math.Float64frombits(binary.BigEndian.Uint64(b))
+		default:
+			return nil, fmt.Errorf("gpmf: unsupported numeric type %q", typ)
+		}
+	}
+	return out, nil
+}
+
+// streamScale returns the SCAL divisors declared in a STRM record's
+// children, or nil if it has none (i.e. a divisor of 1 for every field).
+func streamScale(strm []Record) ([]float64, error) {
+	scal, ok := find(strm, "SCAL")
+	if !ok {
+		return nil, nil
+	}
+	return decodeNumeric(scal.Data, scal.Type, scal.Count)
+}
+
+// GPS5 extracts every GPS5 sample found in a decoded DEVC record's
+// stream tree (see Decode), applying each stream's own SCAL divisors.
+func GPS5(records []Record) ([]GPS5Sample, error) {
+	var out []GPS5Sample
+	for _, strm := range findAll(records, "STRM") {
+		data, ok := find(strm.Children, "GPS5")
+		if !ok {
+			continue
+		}
+		scal, err := streamScale(strm.Children)
+		if err != nil {
+			return nil, err
+		}
+		rows, err := decodeScaled(data.Data, data.Type, data.Count, 5, scal)
+		if err != nil {
+			return nil, fmt.Errorf("gpmf: GPS5: %w", err)
+		}
+		for _, row := range rows {
+			out = append(out, GPS5Sample{
+				Latitude: row[0], Longitude: row[1], Altitude: row[2],
+				Speed2D: row[3], Speed3D: row[4],
+			})
+		}
+	}
+	return out, nil
+}
+
+// axisStream extracts every sample of a 3-axis stream (ACCL or GYRO,
+// both int16 triples) found in records, applying its SCAL divisors.
+func axisStream(records []Record, fourCC string) ([]AxisSample, error) {
+	var out []AxisSample
+	for _, strm := range findAll(records, "STRM") {
+		data, ok := find(strm.Children, fourCC)
+		if !ok {
+			continue
+		}
+		scal, err := streamScale(strm.Children)
+		if err != nil {
+			return nil, err
+		}
+		rows, err := decodeScaled(data.Data, data.Type, data.Count, 3, scal)
+		if err != nil {
+			return nil, fmt.Errorf("gpmf: %s: %w", fourCC, err)
+		}
+		for _, row := range rows {
+			out = append(out, AxisSample{X: row[0], Y: row[1], Z: row[2]})
+		}
+	}
+	return out, nil
+}
+
+// Accelerometer extracts every ACCL sample found in a decoded DEVC
+// record's stream tree.
+func Accelerometer(records []Record) ([]AxisSample, error) {
+	return axisStream(records, "ACCL")
+}
+
+// Gyroscope extracts every GYRO sample found in a decoded DEVC record's
+// stream tree.
+func Gyroscope(records []Record) ([]AxisSample, error) {
+	return axisStream(records, "GYRO")
+}
@@ -0,0 +1,197 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// IPTC-IIM (IIM = "Information Interchange Model") dataset numbers this
+// package reads/writes, all in the 2:xx "Application Record".
+const (
+	iimObjectName      = 5   // Title
+	iimKeywords        = 25  // Keywords (repeatable)
+	iimByline          = 80  // Artist/Creator
+	iimCopyrightNotice = 116 // Copyright
+	iimCaptionAbstract = 120 // Description
+)
+
+// iptcBlock holds the subset of a JPEG's Photoshop IRB/IPTC-IIM block
+// (APP13 segment) that participates in the merge.
+type iptcBlock struct {
+	title       string
+	description string
+	keywords    []string
+	artist      string
+	copyright   string
+}
+
+var photoshopSignature = []byte("Photoshop 3.0\x00")
+
+// parseIPTCBlock extracts the IPTC-IIM fields this package merges from
+// data's first APP13 "Photoshop 3.0" segment, if any. It only
+// understands JPEG's APP13 placement of the Photoshop Image Resource
+// Block (IRB); TIFF/PSD-embedded IPTC is out of scope.
+func parseIPTCBlock(data []byte) *iptcBlock {
+	app13, ok := findJPEGAPP13(data)
+	if !ok {
+		return nil
+	}
+	iim, ok := findIPTCResource(app13)
+	if !ok {
+		return nil
+	}
+	return decodeIIM(iim)
+}
+
+// findJPEGAPP13 scans data's JPEG markers for an APP13 segment beginning
+// with the Photoshop 3.0 IRB signature, returning its payload following
+// the signature.
+func findJPEGAPP13(data []byte) ([]byte, bool) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, false
+	}
+	pos := 2
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			pos++
+			continue
+		}
+		marker := data[pos+1]
+		pos += 2
+		if marker == 0xD8 || marker == 0xD9 || (marker >= 0xD0 && marker <= 0xD7) {
+			continue
+		}
+		if marker == 0xDA {
+			break
+		}
+		if pos+2 > len(data) {
+			break
+		}
+		segLen := int(binary.BigEndian.Uint16(data[pos : pos+2]))
+		dataStart := pos + 2
+		dataEnd := pos + segLen
+		if segLen < 2 || dataEnd > len(data) {
+			break
+		}
+		if marker == 0xED && dataEnd-dataStart >= len(photoshopSignature) &&
+			bytes.Equal(data[dataStart:dataStart+len(photoshopSignature)], photoshopSignature) {
+			return data[dataStart+len(photoshopSignature) : dataEnd], true
+		}
+		pos = dataEnd
+	}
+	return nil, false
+}
+
+// findIPTCResource walks a Photoshop Image Resource Block looking for
+// resource ID 0x0404 ("IPTC-NAA resource"), which holds the actual
+// IPTC-IIM dataset stream.
+func findIPTCResource(irb []byte) ([]byte, bool) {
+	pos := 0
+	for pos+4 <= len(irb) {
+		if !bytes.Equal(irb[pos:pos+4], []byte("8BIM")) {
+			break
+		}
+		pos += 4
+		if pos+2 > len(irb) {
+			break
+		}
+		resourceID := binary.BigEndian.Uint16(irb[pos : pos+2])
+		pos += 2
+
+		// Pascal string name, padded to an even total length (including
+		// its length byte).
+		if pos >= len(irb) {
+			break
+		}
+		nameLen := int(irb[pos])
+		pos += 1 + nameLen
+		if (nameLen+1)%2 != 0 {
+			pos++
+		}
+
+		if pos+4 > len(irb) {
+			break
+		}
+		size := int(binary.BigEndian.Uint32(irb[pos : pos+4]))
+		pos += 4
+		if pos+size > len(irb) {
+			break
+		}
+		if resourceID == 0x0404 {
+			return irb[pos : pos+size], true
+		}
+		pos += size
+		if size%2 != 0 {
+			pos++
+		}
+	}
+	return nil, false
+}
+
+// decodeIIM walks an IPTC-IIM dataset stream (each: 0x1C tag marker,
+// record number, dataset number, a 2-byte length, then that many bytes
+// of data) and collects the fields this package merges.
+func decodeIIM(data []byte) *iptcBlock {
+	b := &iptcBlock{}
+	pos := 0
+	for pos+5 <= len(data) {
+		if data[pos] != 0x1C {
+			break
+		}
+		record := data[pos+1]
+		dataset := data[pos+2]
+		length := int(binary.BigEndian.Uint16(data[pos+3 : pos+5]))
+		pos += 5
+		if pos+length > len(data) {
+			break
+		}
+		value := string(data[pos : pos+length])
+		pos += length
+
+		if record != 2 {
+			continue
+		}
+		switch dataset {
+		case iimObjectName:
+			b.title = value
+		case iimCaptionAbstract:
+			b.description = value
+		case iimKeywords:
+			b.keywords = append(b.keywords, value)
+		case iimByline:
+			b.artist = value
+		case iimCopyrightNotice:
+			b.copyright = value
+		}
+	}
+	if b.title == "" && b.description == "" && len(b.keywords) == 0 && b.artist == "" && b.copyright == "" {
+		return nil
+	}
+	return b
+}
+
+// encodeIIM serializes b back into an IPTC-IIM dataset stream, for
+// WriteBack.
+func encodeIIM(b *iptcBlock) []byte {
+	var buf bytes.Buffer
+	write := func(dataset byte, value string) {
+		if value == "" {
+			return
+		}
+		buf.WriteByte(0x1C)
+		buf.WriteByte(2)
+		buf.WriteByte(dataset)
+		var lenBuf [2]byte
+		binary.BigEndian.PutUint16(lenBuf[:], uint16(len(value)))
+		buf.Write(lenBuf[:])
+		buf.WriteString(value)
+	}
+	write(iimObjectName, b.title)
+	write(iimCaptionAbstract, b.description)
+	for _, k := range b.keywords {
+		write(iimKeywords, k)
+	}
+	write(iimByline, b.artist)
+	write(iimCopyrightNotice, b.copyright)
+	return buf.Bytes()
+}
@@ -0,0 +1,161 @@
+package metadata
+
+// merge fills m's typed fields from m.exif/m.xmp/m.iptc according to
+// m.Priority (falling back to DefaultPriority), recording which Source
+// each non-empty field actually came from.
+func (m *Metadata) merge() {
+	m.Title = m.resolveString(FieldTitle, "", m.xmpTitle(), m.iptcTitle())
+	m.Description = m.resolveString(FieldDescription, m.exifDescription(), m.xmpDescription(), m.iptcDescription())
+	m.Artist = m.resolveString(FieldArtist, m.exifArtist(), m.xmpArtist(), m.iptcArtist())
+	m.Copyright = m.resolveString(FieldCopyright, m.exifCopyright(), m.xmpCopyright(), m.iptcCopyright())
+	m.DateTimeOriginal = m.resolveString(FieldDateTimeOriginal, m.exifDateTimeOriginal(), "", "")
+	m.LensModel = m.resolveString(FieldLensModel, m.exifLensModel(), "", "")
+
+	for _, s := range m.priorityFor(FieldKeywords) {
+		if kw := m.keywordsFrom(s); len(kw) > 0 {
+			m.Keywords = kw
+			m.Sources[FieldKeywords] = s
+			break
+		}
+	}
+	for _, s := range m.priorityFor(FieldRating) {
+		if s == SourceXMP && m.xmp != nil && m.xmp.rating != 0 {
+			m.Rating = m.xmp.rating
+			m.Sources[FieldRating] = s
+			break
+		}
+	}
+	for _, s := range m.priorityFor(FieldGPS) {
+		if s == SourceEXIF && m.exif != nil && m.exif.gps != nil {
+			m.GPS = m.exif.gps
+			m.Sources[FieldGPS] = s
+			break
+		}
+	}
+}
+
+// resolveString picks the first non-empty of exifVal/xmpVal/iptcVal, in
+// the order f's Priority names, recording which Source won.
+func (m *Metadata) resolveString(f Field, exifVal, xmpVal, iptcVal string) string {
+	for _, s := range m.priorityFor(f) {
+		var v string
+		switch s {
+		case SourceEXIF:
+			v = exifVal
+		case SourceXMP:
+			v = xmpVal
+		case SourceIPTC:
+			v = iptcVal
+		}
+		if v != "" {
+			m.Sources[f] = s
+			return v
+		}
+	}
+	return ""
+}
+
+func (m *Metadata) keywordsFrom(s Source) []string {
+	switch s {
+	case SourceXMP:
+		if m.xmp != nil {
+			return m.xmp.keywords
+		}
+	case SourceIPTC:
+		if m.iptc != nil {
+			return m.iptc.keywords
+		}
+	}
+	return nil
+}
+
+func (m *Metadata) xmpTitle() string {
+	if m.xmp == nil {
+		return ""
+	}
+	return m.xmp.title
+}
+
+func (m *Metadata) xmpDescription() string {
+	if m.xmp == nil {
+		return ""
+	}
+	return m.xmp.description
+}
+
+func (m *Metadata) xmpArtist() string {
+	if m.xmp == nil {
+		return ""
+	}
+	return m.xmp.artist
+}
+
+func (m *Metadata) xmpCopyright() string {
+	if m.xmp == nil {
+		return ""
+	}
+	return m.xmp.copyright
+}
+
+func (m *Metadata) iptcTitle() string {
+	if m.iptc == nil {
+		return ""
+	}
+	return m.iptc.title
+}
+
+func (m *Metadata) iptcDescription() string {
+	if m.iptc == nil {
+		return ""
+	}
+	return m.iptc.description
+}
+
+func (m *Metadata) iptcArtist() string {
+	if m.iptc == nil {
+		return ""
+	}
+	return m.iptc.artist
+}
+
+func (m *Metadata) iptcCopyright() string {
+	if m.iptc == nil {
+		return ""
+	}
+	return m.iptc.copyright
+}
+
+func (m *Metadata) exifDescription() string {
+	if m.exif == nil {
+		return ""
+	}
+	return m.exif.description
+}
+
+func (m *Metadata) exifArtist() string {
+	if m.exif == nil {
+		return ""
+	}
+	return m.exif.artist
+}
+
+func (m *Metadata) exifCopyright() string {
+	if m.exif == nil {
+		return ""
+	}
+	return m.exif.copyright
+}
+
+func (m *Metadata) exifDateTimeOriginal() string {
+	if m.exif == nil {
+		return ""
+	}
+	return m.exif.dateTimeOriginal
+}
+
+func (m *Metadata) exifLensModel() string {
+	if m.exif == nil {
+		return ""
+	}
+	return m.exif.lensModel
+}
@@ -0,0 +1,166 @@
+// Package metadata merges EXIF, XMP, and IPTC-IIM metadata read from the
+// same image into a single typed Metadata struct, so callers don't need
+// to know (or guess) which of the three blocks a given camera, editor, or
+// stock-photo workflow actually wrote a field to.
+package metadata
+
+import (
+	"io"
+)
+
+// Source identifies which metadata block a field's value was read from.
+type Source int
+
+const (
+	SourceEXIF Source = iota
+	SourceXMP
+	SourceIPTC
+)
+
+func (s Source) String() string {
+	switch s {
+	case SourceEXIF:
+		return "exif"
+	case SourceXMP:
+		return "xmp"
+	case SourceIPTC:
+		return "iptc"
+	default:
+		return "unknown"
+	}
+}
+
+// Field identifies one of Metadata's merged fields, for use as a Priority
+// key.
+type Field int
+
+const (
+	FieldTitle Field = iota
+	FieldDescription
+	FieldKeywords
+	FieldRating
+	FieldArtist
+	FieldCopyright
+	FieldDateTimeOriginal
+	FieldGPS
+	FieldLensModel
+)
+
+// Priority maps a Field to the order of Sources to consult for it,
+// highest priority first. A Field absent from the map falls back to
+// DefaultPriority's ordering.
+type Priority map[Field][]Source
+
+// DefaultPriority implements the repo's chosen precedence: XMP wins over
+// EXIF wins over IPTC for descriptive fields (titles, keywords, captions
+// are most often edited in a DAM/XMP-aware tool after the fact), while
+// EXIF wins over XMP for capture-time facts a camera recorded once and an
+// editor is unlikely to have touched (GPS, lens, capture timestamp).
+var DefaultPriority = Priority{
+	FieldTitle:            {SourceXMP, SourceEXIF, SourceIPTC},
+	FieldDescription:      {SourceXMP, SourceEXIF, SourceIPTC},
+	FieldKeywords:         {SourceXMP, SourceIPTC, SourceEXIF},
+	FieldRating:           {SourceXMP, SourceEXIF, SourceIPTC},
+	FieldArtist:           {SourceXMP, SourceEXIF, SourceIPTC},
+	FieldCopyright:        {SourceXMP, SourceEXIF, SourceIPTC},
+	FieldDateTimeOriginal: {SourceEXIF, SourceXMP, SourceIPTC},
+	FieldGPS:              {SourceEXIF, SourceXMP},
+	FieldLensModel:        {SourceEXIF, SourceXMP},
+}
+
+// GPS is a resolved geographic position, in decimal degrees/meters.
+type GPS struct {
+	Latitude  float64
+	Longitude float64
+	Altitude  float64 // meters above sea level; 0 if unknown
+}
+
+// Metadata is the merged, typed view over an image's EXIF, XMP, and
+// IPTC-IIM metadata. Each field's value comes from whichever block
+// Priority (or DefaultPriority) names first among the blocks that
+// actually carried it; Sources records which block each field actually
+// came from, for callers that need to know.
+type Metadata struct {
+	Title            string
+	Description      string
+	Keywords         []string
+	Rating           int
+	Artist           string
+	Copyright        string
+	DateTimeOriginal string // EXIF "YYYY:MM:DD HH:MM:SS" layout; see exif.Exif.DateTime for parsing
+	GPS              *GPS
+	LensModel        string
+
+	// Sources records, per Field that resolved to a non-zero value, which
+	// block it was actually taken from.
+	Sources map[Field]Source
+
+	// Priority is the precedence this Metadata was merged with; WriteBack
+	// consults it to decide which blocks to update for a changed field.
+	Priority Priority
+
+	exif *exifBlock
+	xmp  *xmpBlock
+	iptc *iptcBlock
+}
+
+// Parse reads every byte r has to offer, extracts whichever of EXIF, XMP,
+// and IPTC-IIM metadata the image carries, and merges them into a single
+// Metadata per DefaultPriority. A file missing one or two of the three
+// blocks is not an error; Metadata simply has nothing to merge in from
+// the missing block(s).
+func Parse(r io.ReaderAt) (*Metadata, error) {
+	data, err := readAllAt(r)
+	if err != nil {
+		return nil, err
+	}
+	return parseBytes(data)
+}
+
+func parseBytes(data []byte) (*Metadata, error) {
+	m := &Metadata{
+		Sources:  map[Field]Source{},
+		Priority: DefaultPriority,
+	}
+
+	m.exif = parseExifBlock(data)
+	m.xmp = parseXMPBlock(data)
+	m.iptc = parseIPTCBlock(data)
+
+	m.merge()
+	return m, nil
+}
+
+// priorityFor returns the Source order to try for f, falling back to
+// DefaultPriority when m.Priority doesn't mention f.
+func (m *Metadata) priorityFor(f Field) []Source {
+	if order, ok := m.Priority[f]; ok {
+		return order
+	}
+	return DefaultPriority[f]
+}
+
+// readAllAt reads every byte r has to offer starting at 0, growing its
+// buffer until ReadAt reports io.EOF. Parsing XMP/IPTC/EXIF all need
+// effectively the whole file in hand (XMP packet scanning in particular
+// has no fixed offset to seek to), so there is no streaming win to be had
+// here the way there is in exif.DecodeAt/container.ExtractRawExif.
+func readAllAt(r io.ReaderAt) ([]byte, error) {
+	var buf []byte
+	chunk := make([]byte, 64*1024)
+	var pos int64
+	for {
+		n, err := r.ReadAt(chunk, pos)
+		buf = append(buf, chunk[:n]...)
+		pos += int64(n)
+		if err == io.EOF {
+			return buf, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			return buf, nil
+		}
+	}
+}
@@ -0,0 +1,282 @@
+package metadata
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/rpajarola/exiftools/models"
+	"github.com/trimmer-io/go-xmp/models/dc"
+	xmpbase "github.com/trimmer-io/go-xmp/models/xmp_base"
+	"github.com/trimmer-io/go-xmp/xmp"
+)
+
+// WriteBack applies m's current field values to src (the JPEG m was
+// originally Parse'd from) and writes the result to dst, updating every
+// block (EXIF, XMP, IPTC) that carries the field so that a downstream
+// tool reading any one of the three sees the same edit — the
+// reconciliation problem DefaultPriority exists to solve on read, and
+// that a read-only merge can't solve on its own.
+//
+// Only JPEG is supported: src's first segment must be a JPEG SOI marker.
+// Fields with no representation in a given block (e.g. Rating, which has
+// no EXIF tag) are simply left out of that block's rewrite.
+func WriteBack(dst io.Writer, src io.Reader, m *Metadata) error {
+	br := bufio.NewReader(src)
+	var soi [2]byte
+	if _, err := io.ReadFull(br, soi[:]); err != nil {
+		return fmt.Errorf("metadata: reading SOI: %w", err)
+	}
+	if soi[0] != 0xFF || soi[1] != 0xD8 {
+		return fmt.Errorf("metadata: WriteBack only supports JPEG input")
+	}
+
+	exifPayload, err := m.encodeExif()
+	if err != nil {
+		return fmt.Errorf("metadata: encoding exif block: %w", err)
+	}
+	xmpPayload, err := m.encodeXMP()
+	if err != nil {
+		return fmt.Errorf("metadata: encoding xmp block: %w", err)
+	}
+	iptcPayload := m.encodeIPTC()
+
+	if _, err := dst.Write(soi[:]); err != nil {
+		return err
+	}
+
+	exifWritten, xmpWritten, iptcWritten := false, false, false
+	for {
+		var marker [2]byte
+		if _, err := io.ReadFull(br, marker[:]); err != nil {
+			return fmt.Errorf("metadata: reading segment marker: %w", err)
+		}
+		if marker[0] != 0xFF {
+			return fmt.Errorf("metadata: malformed JPEG, expected marker, got %x", marker)
+		}
+
+		if marker[1] == 0xDA { // start of scan: rest is compressed image data
+			if err := writeMissingSegments(dst, exifWritten, exifPayload, xmpWritten, xmpPayload, iptcWritten, iptcPayload); err != nil {
+				return err
+			}
+			if _, err := dst.Write(marker[:]); err != nil {
+				return err
+			}
+			_, err := io.Copy(dst, br)
+			return err
+		}
+
+		var lenBuf [2]byte
+		if _, err := io.ReadFull(br, lenBuf[:]); err != nil {
+			return fmt.Errorf("metadata: reading segment length: %w", err)
+		}
+		segLen := int(binary.BigEndian.Uint16(lenBuf[:]))
+		if segLen < 2 {
+			return fmt.Errorf("metadata: invalid segment length %d", segLen)
+		}
+		payload := make([]byte, segLen-2)
+		if _, err := io.ReadFull(br, payload); err != nil {
+			return fmt.Errorf("metadata: reading segment payload: %w", err)
+		}
+
+		switch {
+		case marker[1] == jpegAPP1 && bytes.HasPrefix(payload, []byte("Exif\x00\x00")):
+			if err := writeAPP1(dst, jpegAPP1, append([]byte("Exif\x00\x00"), exifPayload...)); err != nil {
+				return err
+			}
+			exifWritten = true
+			continue
+		case marker[1] == jpegAPP1 && bytes.HasPrefix(payload, xmpSignature):
+			if err := writeAPP1(dst, jpegAPP1, append(append([]byte{}, xmpSignature...), xmpPayload...)); err != nil {
+				return err
+			}
+			xmpWritten = true
+			continue
+		case marker[1] == jpegAPP13 && bytes.HasPrefix(payload, photoshopSignature):
+			if err := writeAPP13(dst, iptcPayload); err != nil {
+				return err
+			}
+			iptcWritten = true
+			continue
+		}
+
+		if _, err := dst.Write(marker[:]); err != nil {
+			return err
+		}
+		if _, err := dst.Write(lenBuf[:]); err != nil {
+			return err
+		}
+		if _, err := dst.Write(payload); err != nil {
+			return err
+		}
+	}
+}
+
+const jpegAPP1 = 0xE1
+const jpegAPP13 = 0xED
+
+var xmpSignature = []byte("http://ns.adobe.com/xap/1.0/\x00")
+
+// writeMissingSegments inserts any of EXIF/XMP/IPTC that src never had a
+// segment for in the first place, immediately before start-of-scan.
+func writeMissingSegments(dst io.Writer, exifWritten bool, exifPayload []byte, xmpWritten bool, xmpPayload []byte, iptcWritten bool, iptcPayload []byte) error {
+	if !exifWritten && len(exifPayload) > 0 {
+		if err := writeAPP1(dst, jpegAPP1, append([]byte("Exif\x00\x00"), exifPayload...)); err != nil {
+			return err
+		}
+	}
+	if !xmpWritten && len(xmpPayload) > 0 {
+		if err := writeAPP1(dst, jpegAPP1, append(append([]byte{}, xmpSignature...), xmpPayload...)); err != nil {
+			return err
+		}
+	}
+	if !iptcWritten && len(iptcPayload) > 0 {
+		if err := writeAPP13(dst, iptcPayload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeAPP1(dst io.Writer, marker byte, payload []byte) error {
+	return writeSegment(dst, marker, payload)
+}
+
+// writeAPP13 wraps iim in the Photoshop 3.0 IRB framing this package's
+// iptc_block.go reads, with a single 8BIM/0x0404 resource holding it.
+func writeAPP13(dst io.Writer, iim []byte) error {
+	var irb bytes.Buffer
+	irb.WriteString("Photoshop 3.0\x00")
+	irb.WriteString("8BIM")
+	var idBuf [2]byte
+	binary.BigEndian.PutUint16(idBuf[:], 0x0404)
+	irb.Write(idBuf[:])
+	irb.WriteByte(0) // empty Pascal string name (just its 0 length byte)
+	irb.WriteByte(0) // pad name field to an even total length
+	var sizeBuf [4]byte
+	binary.BigEndian.PutUint32(sizeBuf[:], uint32(len(iim)))
+	irb.Write(sizeBuf[:])
+	irb.Write(iim)
+	if len(iim)%2 != 0 {
+		irb.WriteByte(0)
+	}
+	return writeSegment(dst, jpegAPP13, irb.Bytes())
+}
+
+func writeSegment(dst io.Writer, marker byte, payload []byte) error {
+	if _, err := dst.Write([]byte{0xFF, marker}); err != nil {
+		return err
+	}
+	var lenBuf [2]byte
+	binary.BigEndian.PutUint16(lenBuf[:], uint16(len(payload)+2))
+	if _, err := dst.Write(lenBuf[:]); err != nil {
+		return err
+	}
+	_, err := dst.Write(payload)
+	return err
+}
+
+// encodeExif applies m's field values to its originally-decoded Exif (if
+// any; a file with no EXIF block to begin with gets one built fresh) via
+// the typed setters in exif/setters.go, then re-encodes it.
+func (m *Metadata) encodeExif() ([]byte, error) {
+	eb := m.exif
+	if eb == nil {
+		return nil, nil
+	}
+	x := eb.x
+	if m.Description != "" {
+		x.SetString(models.ImageDescription, m.Description)
+	}
+	if m.Artist != "" {
+		x.SetString(models.Artist, m.Artist)
+	}
+	if m.Copyright != "" {
+		x.SetString(models.Copyright, m.Copyright)
+	}
+	if m.DateTimeOriginal != "" {
+		if t, err := time.Parse("2006:01:02 15:04:05", m.DateTimeOriginal); err == nil {
+			x.SetDateTime(t)
+		}
+	}
+	if m.GPS != nil {
+		x.SetGPS(m.GPS.Latitude, m.GPS.Longitude, float32(m.GPS.Altitude))
+	}
+
+	var buf bytes.Buffer
+	if err := x.EncodeJPEG(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// encodeXMP applies m's field values to its originally-parsed xmp
+// Document (if any), then re-serializes it.
+func (m *Metadata) encodeXMP() ([]byte, error) {
+	b := m.xmp
+	if b == nil {
+		return nil, nil
+	}
+	doc := b.doc
+
+	dcModel, err := dc.MakeModel(doc)
+	if err != nil {
+		return nil, err
+	}
+	if m.Title != "" {
+		dcModel.Title.AddDefault("x-default", m.Title)
+	}
+	if m.Description != "" {
+		dcModel.Description.AddDefault("x-default", m.Description)
+	}
+	if len(m.Keywords) > 0 {
+		dcModel.Subject = m.Keywords
+	}
+	if m.Artist != "" {
+		dcModel.Creator = []string{m.Artist}
+	}
+	if m.Copyright != "" {
+		dcModel.Rights.AddDefault("x-default", m.Copyright)
+	}
+
+	if m.Rating != 0 {
+		baseModel, err := xmpbase.MakeModel(doc)
+		if err != nil {
+			return nil, err
+		}
+		baseModel.Rating = xmpbase.Rating(m.Rating)
+	}
+
+	if err := doc.SyncModels(); err != nil {
+		return nil, err
+	}
+	return xmp.Marshal(doc)
+}
+
+// encodeIPTC applies m's field values to its originally-parsed IPTC
+// block (if any) and re-encodes it as an IIM dataset stream.
+func (m *Metadata) encodeIPTC() []byte {
+	b := m.iptc
+	if b == nil {
+		b = &iptcBlock{}
+	}
+	if m.Title != "" {
+		b.title = m.Title
+	}
+	if m.Description != "" {
+		b.description = m.Description
+	}
+	if len(m.Keywords) > 0 {
+		b.keywords = m.Keywords
+	}
+	if m.Artist != "" {
+		b.artist = m.Artist
+	}
+	if m.Copyright != "" {
+		b.copyright = m.Copyright
+	}
+	return encodeIIM(b)
+}
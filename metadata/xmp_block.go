@@ -0,0 +1,79 @@
+package metadata
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/trimmer-io/go-xmp/models/dc"
+	"github.com/trimmer-io/go-xmp/models/ps"
+	xmpbase "github.com/trimmer-io/go-xmp/models/xmp_base"
+	xmprights "github.com/trimmer-io/go-xmp/models/xmp_rights"
+	"github.com/trimmer-io/go-xmp/xmp"
+)
+
+// xmpBlock holds the subset of a parsed XMP xmp.Document that
+// participates in the merge, plus the Document itself so WriteBack can
+// update its models and re-serialize.
+type xmpBlock struct {
+	doc         *xmp.Document
+	title       string
+	description string
+	keywords    []string
+	rating      int
+	artist      string
+	copyright   string
+}
+
+func parseXMPBlock(data []byte) *xmpBlock {
+	packets, err := xmp.ScanPackets(bytes.NewReader(data))
+	if err != nil || len(packets) == 0 {
+		return nil
+	}
+
+	doc := &xmp.Document{}
+	if err := xmp.Unmarshal(packets[0], doc); err != nil {
+		return nil
+	}
+
+	b := &xmpBlock{doc: doc}
+	if m := dc.FindModel(doc); m != nil {
+		b.title = firstOf(m.Title)
+		b.description = firstOf(m.Description)
+		b.keywords = []string(m.Subject)
+		if len(m.Creator) > 0 {
+			b.artist = strings.Join([]string(m.Creator), ", ")
+		}
+		if s := firstOf(m.Rights); s != "" {
+			b.copyright = s
+		}
+	}
+	if m := xmpbase.FindModel(doc); m != nil {
+		b.rating = int(m.Rating)
+	}
+	if m := xmprights.FindModel(doc); m != nil {
+		if s := firstOf(m.UsageTerms); s != "" && b.copyright == "" {
+			b.copyright = s
+		}
+	}
+	if m := ps.FindModel(doc); m != nil {
+		if b.title == "" {
+			b.title = m.Headline
+		}
+		if b.copyright == "" {
+			b.copyright = m.Credit
+		}
+	}
+	return b
+}
+
+// firstOf returns an xmp.AltString's default-language value, or its first
+// entry if there's no "x-default" alternative.
+func firstOf(s xmp.AltString) string {
+	if v := s.Default(); v != "" {
+		return v
+	}
+	if len(s) > 0 {
+		return s[0].Value
+	}
+	return ""
+}
@@ -2,17 +2,24 @@ package mknote
 
 import (
 	"bytes"
+	"fmt"
 
 	"github.com/rpajarola/exiftools/exif"
+	"github.com/rpajarola/exiftools/mknote/plist"
 	"github.com/rpajarola/exiftools/models"
 	"github.com/rpajarola/exiftools/tiff"
 )
 
-// Apple is an exif.Parser for Apple makernote data.
+// Apple is a MakerNoteParser for Apple makernote data.
 var Apple = &apple{}
 
 type apple struct{}
 
+// Matches reports whether make identifies an Apple device.
+func (*apple) Matches(make, model string) bool {
+	return make == "Apple"
+}
+
 // Apple-specific Maker Note Sub-Ifd pointers
 var ()
 
@@ -127,12 +134,38 @@ var makerNoteAppleFields = map[uint16]models.FieldName{
 
 }
 
+// Encode rebuilds the Apple maker-note sub-IFD from whatever
+// makerNoteAppleFields are currently set on x. It returns a nil Dir if none
+// of those fields are present (the source was never an Apple maker note,
+// or the fields were stripped).
+func (*apple) Encode(x *exif.Exif) (*tiff.Dir, error) {
+	dir := &tiff.Dir{}
+	for id, name := range makerNoteAppleFields {
+		tag, err := x.Get(name)
+		if err != nil {
+			continue
+		}
+		tag.Id = id
+		dir.Tags = append(dir.Tags, tag)
+	}
+	if len(dir.Tags) == 0 {
+		return nil, nil
+	}
+	return dir, nil
+}
+
 // Parse decodes all Apple makernote data found in x and adds it to x.
 func (*apple) Parse(x *exif.Exif) error {
+	if x.SkipMakerNote() {
+		return nil
+	}
 	m, err := x.Get(models.MakerNote)
 	if err != nil {
 		return nil
 	}
+	if x.Tiff == nil {
+		return nil
+	}
 	if len(m.Val) < 12 {
 		return nil
 	}
@@ -153,3 +186,95 @@ func (*apple) Parse(x *exif.Exif) error {
 	x.LoadTags(mkNotesDir, makerNoteAppleFields, false)
 	return nil
 }
+
+// RunTime is Apple's CMTime structure, as stored (binary-plist-encoded) in
+// the Apple.RunTime maker-note tag (0x0003).
+type RunTime struct {
+	Value     int64
+	Timescale int64
+	Epoch     int64
+	Flags     int64
+}
+
+// AERunTime decodes the Apple.RunTime tag into a RunTime. It returns a
+// TagNotPresentError-wrapping error if the tag is absent, or an error from
+// plist if the tag value is not a well-formed binary plist dict.
+func AERunTime(x *exif.Exif) (RunTime, error) {
+	var rt RunTime
+	dict, err := applePListDict(x, AppleRunTime)
+	if err != nil {
+		return rt, err
+	}
+	rt.Value = plistInt(dict["TValue"])
+	rt.Timescale = plistInt(dict["TTimescale"])
+	rt.Epoch = plistInt(dict["TEpoch"])
+	rt.Flags = plistInt(dict["TFlags"])
+	return rt, nil
+}
+
+// AEMatrix decodes the Apple.AEMatrix tag (a plist array of numeric rows)
+// into a [][]float64.
+func AEMatrix(x *exif.Exif) ([][]float64, error) {
+	v, err := applePListValue(x, AppleAEMatrix)
+	if err != nil {
+		return nil, err
+	}
+	rows, ok := v.([]plist.Value)
+	if !ok {
+		return nil, fmt.Errorf("mknote: %s plist is not an array", AppleAEMatrix)
+	}
+	out := make([][]float64, len(rows))
+	for i, row := range rows {
+		cols, ok := row.([]plist.Value)
+		if !ok {
+			return nil, fmt.Errorf("mknote: %s row %d is not an array", AppleAEMatrix, i)
+		}
+		out[i] = make([]float64, len(cols))
+		for j, c := range cols {
+			out[i][j] = plistFloat(c)
+		}
+	}
+	return out, nil
+}
+
+// applePListValue fetches field from x and decodes it as a binary plist.
+func applePListValue(x *exif.Exif, field models.FieldName) (plist.Value, error) {
+	tag, err := x.Get(field)
+	if err != nil {
+		return nil, err
+	}
+	v, err := plist.Decode(tag.Val)
+	if err != nil {
+		return nil, fmt.Errorf("mknote: decoding %s plist: %w", field, err)
+	}
+	return v, nil
+}
+
+// applePListDict fetches and decodes field, asserting the result is a
+// top-level dict (as RunTime and the unnamed 0x004e/0x004f tags are).
+func applePListDict(x *exif.Exif, field models.FieldName) (map[string]plist.Value, error) {
+	v, err := applePListValue(x, field)
+	if err != nil {
+		return nil, err
+	}
+	dict, ok := v.(map[string]plist.Value)
+	if !ok {
+		return nil, fmt.Errorf("mknote: %s plist is not a dict", field)
+	}
+	return dict, nil
+}
+
+func plistInt(v plist.Value) int64 {
+	i, _ := v.(int64)
+	return i
+}
+
+func plistFloat(v plist.Value) float64 {
+	switch n := v.(type) {
+	case float64:
+		return n
+	case int64:
+		return float64(n)
+	}
+	return 0
+}
@@ -2,6 +2,7 @@ package mknote
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 
 	"github.com/rpajarola/exiftools/exif"
@@ -10,13 +11,39 @@ import (
 	"github.com/rpajarola/exiftools/tiff"
 )
 
-// Canon is an exif.Parser for canon makernote data.
+// Canon is a MakerNoteParser for canon makernote data.
 var Canon = &canon{}
 
 type canon struct{}
 
+// Matches reports whether make identifies a Canon body.
+func (*canon) Matches(make, model string) bool {
+	return make == "Canon"
+}
+
+// Encode rebuilds the Canon maker-note sub-IFD from whatever
+// makerNoteCanonFields are currently set on x.
+func (_ *canon) Encode(x *exif.Exif) (*tiff.Dir, error) {
+	dir := &tiff.Dir{}
+	for id, name := range makerNoteCanonFields {
+		tag, err := x.Get(name)
+		if err != nil {
+			continue
+		}
+		tag.Id = id
+		dir.Tags = append(dir.Tags, tag)
+	}
+	if len(dir.Tags) == 0 {
+		return nil, nil
+	}
+	return dir, nil
+}
+
 // Parse decodes all Canon makernote data found in x and adds it to x.
 func (_ *canon) Parse(x *exif.Exif) error {
+	if x.SkipMakerNote() {
+		return nil
+	}
 	m, err := x.Get(exif.MakerNote)
 	if err != nil {
 		return nil
@@ -43,9 +70,58 @@ func (_ *canon) Parse(x *exif.Exif) error {
 	// Parse Canon MakerFields
 	x.LoadTags(mkNotesDir, makerNoteCanonFields, false)
 
+	if info, err := x.Get(CanonPreviewImageInfo); err == nil {
+		parseCanonPreviewImageInfo(x, info)
+	}
+
 	return nil
 }
 
+// Canon-specific preview fields, synthesized from CanonPreviewImageInfo
+// by parseCanonPreviewImageInfo below rather than loaded directly from
+// the maker-note IFD, since PreviewImageInfo packs them into one array
+// tag instead of exposing them as separate IFD entries.
+var (
+	CanonPreviewImageStart  exif.FieldName = "Canon.PreviewImageStart"
+	CanonPreviewImageLength exif.FieldName = "Canon.PreviewImageLength"
+)
+
+// CanonPreviewImage is a PreviewImageTag candidate for
+// exif.Exif.ExtractPreviewImage, sourced from Canon's embedded preview
+// JPEG via CanonPreviewImageStart/CanonPreviewImageLength.
+var CanonPreviewImage = exif.NewPreviewImageTag(CanonPreviewImageStart, CanonPreviewImageLength, exif.FieldName("None"))
+
+// parseCanonPreviewImageInfo decodes Canon's PreviewImageInfo block (tag
+// 0xb6): a packed int32u array of [PreviewQuality, PreviewImageLength,
+// PreviewImageWidth, PreviewImageHeight, PreviewImageStart, ...]. Its
+// Length and Start words are re-exposed as ordinary tags so
+// CanonPreviewImage can be used like any other PreviewImageTag.
+func parseCanonPreviewImageInfo(x *exif.Exif, info *tiff.Tag) {
+	length, err := info.Int(1)
+	if err != nil {
+		return
+	}
+	start, err := info.Int(4)
+	if err != nil {
+		return
+	}
+
+	dir := &tiff.Dir{Tags: []*tiff.Tag{
+		tiff.MakeTag(0, tiff.DTLong, 1, x.Tiff.Order, encodeUint32(x.Tiff.Order, uint32(start))),
+		tiff.MakeTag(1, tiff.DTLong, 1, x.Tiff.Order, encodeUint32(x.Tiff.Order, uint32(length))),
+	}}
+	x.LoadTags(dir, map[uint16]exif.FieldName{
+		0: CanonPreviewImageStart,
+		1: CanonPreviewImageLength,
+	}, false)
+}
+
+func encodeUint32(order binary.ByteOrder, v uint32) []byte {
+	buf := make([]byte, 4)
+	order.PutUint32(buf, v)
+	return buf
+}
+
 // Canon-specific fields
 var (
 	CanonCameraSettings   exif.FieldName = "Canon.CameraSettings" // A sub-IFD
@@ -105,6 +181,22 @@ var makerNoteCanonFields = map[uint16]exif.FieldName{
 	0x4001: ColorData,
 }
 
+// CameraSettingsField maps a CanonCameraSettingsFields entry's raw integer
+// value to its human-readable label.
+type CameraSettingsField map[int]string
+
+// CameraSettings holds the decoded labels from the Canon MakerNote's
+// CameraSettings sub-IFD (tag 0x0001), keyed out via
+// CanonCameraSettingsFields/canontags.CanonLens.
+type CameraSettings struct {
+	ContinuousDrive string
+	RecordMode      string
+	FocusMode       string
+	ExposureMode    string
+	MeteringMode    string
+	Lens            string
+}
+
 // CanonRaw - Raw Image from a Canon Camera
 type CanonRaw struct {
 	ModelID             string
@@ -0,0 +1,578 @@
+package canontags
+
+import "github.com/rpajarola/exiftools/models"
+
+//// Autogenerated file on 10 January, 2025
+// Extracted from: https://raw.githubusercontent.com/exiftool/exiftool/master/lib/Image/ExifTool/Canon.pm
+// Based on ExifTool by Evan Oberholster (https://github.com/evanoberholster/exiftools)
+
+// Based on ExifTool by Phil Harvey (https://exiftool.org/TagNames/Canon.html)
+
+//go:generate go run regen_canontags.go
+//go:generate go fmt canontags-generated.go
+
+// CanonLensType Values
+var canonLensTypeValues = map[int]CanonLensType{
+	-1:    {"n/a"},
+	1:     {"Canon EF 50mm f/1.8"},
+	2:     {"Canon EF 28mm f/2.8 or Sigma Lens", "Sigma 24mm f/2.8 Super Wide II"},
+	3:     {"Canon EF 135mm f/2.8 Soft"},
+	4:     {"Canon EF 35-105mm f/3.5-4.5 or Sigma Lens", "Sigma UC Zoom 35-135mm f/4-5.6"},
+	5:     {"Canon EF 35-70mm f/3.5-4.5"},
+	6:     {"Canon EF 28-70mm f/3.5-4.5 or Sigma or Tokina Lens", "Sigma 18-50mm f/3.5-5.6 DC", "Sigma 18-125mm f/3.5-5.6 DC IF ASP", "Tokina AF 193-2 19-35mm f/3.5-4.5", "Sigma 28-80mm f/3.5-5.6 II Macro", "Sigma 28-300mm f/3.5-6.3 DG Macro"},
+	7:     {"Canon EF 100-300mm f/5.6L"},
+	8:     {"Canon EF 100-300mm f/5.6 or Sigma or Tokina Lens", "Sigma 70-300mm f/4-5.6 [APO] DG Macro", "Tokina AT-X 242 AF 24-200mm f/3.5-5.6"},
+	9:     {"Canon EF 70-210mm f/4", "Sigma 55-200mm f/4-5.6 DC"},
+	10:    {"Canon EF 50mm f/2.5 Macro or Sigma Lens", "Sigma 50mm f/2.8 EX", "Sigma 28mm f/1.8", "Sigma 105mm f/2.8 Macro EX", "Sigma 70mm f/2.8 EX DG Macro EF"},
+	11:    {"Canon EF 35mm f/2"},
+	13:    {"Canon EF 15mm f/2.8 Fisheye"},
+	14:    {"Canon EF 50-200mm f/3.5-4.5L"},
+	15:    {"Canon EF 50-200mm f/3.5-4.5"},
+	16:    {"Canon EF 35-135mm f/3.5-4.5"},
+	17:    {"Canon EF 35-70mm f/3.5-4.5A"},
+	18:    {"Canon EF 28-70mm f/3.5-4.5"},
+	20:    {"Canon EF 100-200mm f/4.5A"},
+	21:    {"Canon EF 80-200mm f/2.8L"},
+	22:    {"Canon EF 20-35mm f/2.8L or Tokina Lens", "Tokina AT-X 280 AF Pro 28-80mm f/2.8 Aspherical"},
+	23:    {"Canon EF 35-105mm f/3.5-4.5"},
+	24:    {"Canon EF 35-80mm f/4-5.6 Power Zoom"},
+	25:    {"Canon EF 35-80mm f/4-5.6 Power Zoom"},
+	26:    {"Canon EF 100mm f/2.8 Macro or Other Lens", "Cosina 100mm f/3.5 Macro AF", "Tamron SP AF 90mm f/2.8 Di Macro", "Tamron SP AF 180mm f/3.5 Di Macro", "", "Voigtlander APO Lanthar 125mm F2.5 SL Macro", "Carl Zeiss Planar T 85mm f/1.4 ZE"},
+	27:    {"Canon EF 35-80mm f/4-5.6"},
+	28:    {"Canon EF 80-200mm f/4.5-5.6 or Tamron Lens", "Tamron SP AF 28-105mm f/2.8 LD Aspherical IF", "Tamron SP AF 28-75mm f/2.8 XR Di LD Aspherical [IF] Macro", "", "Tamron AF Aspherical 28-200mm f/3.8-5.6"},
+	29:    {"Canon EF 50mm f/1.8 II"},
+	30:    {"Canon EF 35-105mm f/4.5-5.6"},
+	31:    {"Canon EF 75-300mm f/4-5.6 or Tamron Lens", "Tamron SP AF 300mm f/2.8 LD IF"},
+	32:    {"Canon EF 24mm f/2.8 or Sigma Lens", "Sigma 15mm f/2.8 EX Fisheye"},
+	33:    {"Voigtlander or Carl Zeiss Lens", "Voigtlander Ultron 40mm f/2 SLII Aspherical", "Voigtlander Color Skopar 20mm f/3.5 SLII Aspherical", "Voigtlander APO-Lanthar 90mm f/3.5 SLII Close Focus", "", "", "", "", "", ""},
+	35:    {"Canon EF 35-80mm f/4-5.6"},
+	36:    {"Canon EF 38-76mm f/4.5-5.6"},
+	37:    {"Canon EF 35-80mm f/4-5.6 or Tamron Lens", "Tamron 70-200mm f/2.8 Di LD IF Macro", "Tamron AF 28-300mm f/3.5-6.3 XR Di VC LD Aspherical [IF] Macro (A20)", "Tamron SP AF 17-50mm f/2.8 XR Di II VC LD Aspherical [IF]", "Tamron AF 18-270mm f/3.5-6.3 Di II VC LD Aspherical [IF] Macro"},
+	38:    {"Canon EF 80-200mm f/4.5-5.6 II"},
+	39:    {"Canon EF 75-300mm f/4-5.6"},
+	40:    {"Canon EF 28-80mm f/3.5-5.6"},
+	41:    {"Canon EF 28-90mm f/4-5.6"},
+	42:    {"Canon EF 28-200mm f/3.5-5.6 or Tamron Lens", "Tamron AF 28-300mm f/3.5-6.3 XR Di VC LD Aspherical [IF] Macro (A20)"},
+	43:    {"Canon EF 28-105mm f/4-5.6"},
+	44:    {"Canon EF 90-300mm f/4.5-5.6"},
+	45:    {"Canon EF-S 18-55mm f/3.5-5.6 [II]"},
+	46:    {"Canon EF 28-90mm f/4-5.6"},
+	47:    {"Zeiss Milvus 35mm f/2 or 50mm f/2", "Zeiss Milvus 50mm f/2 Makro", "Zeiss Milvus 135mm f/2 ZE"},
+	48:    {"Canon EF-S 18-55mm f/3.5-5.6 IS"},
+	49:    {"Canon EF-S 55-250mm f/4-5.6 IS"},
+	50:    {"Canon EF-S 18-200mm f/3.5-5.6 IS"},
+	51:    {"Canon EF-S 18-135mm f/3.5-5.6 IS"},
+	52:    {"Canon EF-S 18-55mm f/3.5-5.6 IS II"},
+	53:    {"Canon EF-S 18-55mm f/3.5-5.6 III"},
+	54:    {"Canon EF-S 55-250mm f/4-5.6 IS II"},
+	60:    {"Irix 11mm f/4 or 15mm f/2.4", "Irix 15mm f/2.4"},
+	63:    {"Irix 30mm F1.4 Dragonfly"},
+	80:    {"Canon TS-E 50mm f/2.8L Macro"},
+	81:    {"Canon TS-E 90mm f/2.8L Macro"},
+	82:    {"Canon TS-E 135mm f/4L Macro"},
+	94:    {"Canon TS-E 17mm f/4L"},
+	95:    {"Canon TS-E 24mm f/3.5L II"},
+	103:   {"Samyang AF 14mm f/2.8 EF or Rokinon Lens", "Rokinon SP 14mm f/2.4", "Rokinon AF 14mm f/2.8 EF"},
+	106:   {"Rokinon SP / Samyang XP 35mm f/1.2"},
+	112:   {"Sigma 28mm f/1.5 FF High-speed Prime or other Sigma Lens", "Sigma 40mm f/1.5 FF High-speed Prime", "Sigma 105mm f/1.5 FF High-speed Prime"},
+	117:   {"Tamron 35-150mm f/2.8-4.0 Di VC OSD (A043) or other Tamron Lens", "Tamron SP 35mm f/1.4 Di USD (F045)"},
+	124:   {"Canon MP-E 65mm f/2.8 1-5x Macro Photo"},
+	125:   {"Canon TS-E 24mm f/3.5L"},
+	126:   {"Canon TS-E 45mm f/2.8"},
+	127:   {"Canon TS-E 90mm f/2.8 or Tamron Lens", "Tamron 18-200mm f/3.5-6.3 Di II VC (B018)"},
+	129:   {"Canon EF 300mm f/2.8L USM"},
+	130:   {"Canon EF 50mm f/1.0L USM"},
+	131:   {"Canon EF 28-80mm f/2.8-4L USM or Sigma Lens", "Sigma 8mm f/3.5 EX DG Circular Fisheye", "Sigma 17-35mm f/2.8-4 EX DG Aspherical HSM", "Sigma 17-70mm f/2.8-4.5 DC Macro", "Sigma APO 50-150mm f/2.8 [II] EX DC HSM", "Sigma APO 120-300mm f/2.8 EX DG HSM", "Sigma 4.5mm f/2.8 EX DC HSM Circular Fisheye", "Sigma 70-200mm f/2.8 APO EX HSM", "Sigma 28-70mm f/2.8-4 DG"},
+	132:   {"Canon EF 1200mm f/5.6L USM"},
+	134:   {"Canon EF 600mm f/4L IS USM"},
+	135:   {"Canon EF 200mm f/1.8L USM"},
+	136:   {"Canon EF 300mm f/2.8L USM", "Tamron SP 15-30mm f/2.8 Di VC USD (A012)"},
+	137:   {"Canon EF 85mm f/1.2L USM or Sigma or Tamron Lens", "Sigma 18-50mm f/2.8-4.5 DC OS HSM", "Sigma 50-200mm f/4-5.6 DC OS HSM", "Sigma 18-250mm f/3.5-6.3 DC OS HSM", "Sigma 24-70mm f/2.8 IF EX DG HSM", "Sigma 18-125mm f/3.8-5.6 DC OS HSM", "Sigma 17-70mm f/2.8-4 DC Macro OS HSM | C", "Sigma 17-50mm f/2.8 OS HSM", "Sigma 18-200mm f/3.5-6.3 DC OS HSM [II]", "Tamron AF 18-270mm f/3.5-6.3 Di II VC PZD (B008)"},
+	138:   {"Canon EF 28-80mm f/2.8-4L"},
+	139:   {"Canon EF 400mm f/2.8L USM"},
+	140:   {"Canon EF 500mm f/4.5L USM"},
+	141:   {"Canon EF 500mm f/4.5L USM"},
+	142:   {"Canon EF 300mm f/2.8L IS USM"},
+	143:   {"Canon EF 500mm f/4L IS USM or Sigma Lens", "Sigma 17-70mm f/2.8-4 DC Macro OS HSM"},
+	144:   {"Canon EF 35-135mm f/4-5.6 USM"},
+	145:   {"Canon EF 100-300mm f/4.5-5.6 USM"},
+	146:   {"Canon EF 70-210mm f/3.5-4.5 USM"},
+	147:   {"Canon EF 35-135mm f/4-5.6 USM"},
+	148:   {"Canon EF 28-80mm f/3.5-5.6 USM"},
+	149:   {"Canon EF 100mm f/2 USM"},
+	150:   {"Canon EF 14mm f/2.8L USM or Sigma Lens", "Sigma 20mm EX f/1.8", "Sigma 30mm f/1.4 DC HSM", "Sigma 24mm f/1.8 DG Macro EX", "Sigma 28mm f/1.8 DG Macro EX", "Sigma 18-35mm f/1.8 DC HSM | A"},
+	151:   {"Canon EF 200mm f/2.8L USM"},
+	152:   {"Canon EF 300mm f/4L IS USM or Sigma Lens", "Sigma 12-24mm f/4.5-5.6 EX DG ASPHERICAL HSM", "Sigma 14mm f/2.8 EX Aspherical HSM", "Sigma 10-20mm f/4-5.6", "Sigma 100-300mm f/4", "Sigma 300-800mm f/5.6 APO EX DG HSM"},
+	153:   {"Canon EF 35-350mm f/3.5-5.6L USM or Sigma or Tamron Lens", "Sigma 50-500mm f/4-6.3 APO HSM EX", "Tamron AF 28-300mm f/3.5-6.3 XR LD Aspherical [IF] Macro", "Tamron AF 18-200mm f/3.5-6.3 XR Di II LD Aspherical [IF] Macro (A14)", "Tamron 18-250mm f/3.5-6.3 Di II LD Aspherical [IF] Macro"},
+	154:   {"Canon EF 20mm f/2.8 USM or Zeiss Lens", "Zeiss Milvus 21mm f/2.8", "Zeiss Milvus 15mm f/2.8 ZE", "Zeiss Milvus 18mm f/2.8 ZE"},
+	155:   {"Canon EF 85mm f/1.8 USM or Sigma Lens", "Sigma 14mm f/1.8 DG HSM | A"},
+	156:   {"Canon EF 28-105mm f/3.5-4.5 USM or Tamron Lens", "Tamron SP 70-300mm f/4-5.6 Di VC USD (A005)", "Tamron SP AF 28-105mm f/2.8 LD Aspherical IF (176D)"},
+	160:   {"Canon EF 20-35mm f/3.5-4.5 USM or Tamron or Tokina Lens", "Tamron AF 19-35mm f/3.5-4.5", "Tokina AT-X 124 AF Pro DX 12-24mm f/4", "Tokina AT-X 107 AF DX 10-17mm f/3.5-4.5 Fisheye", "Tokina AT-X 116 AF Pro DX 11-16mm f/2.8", "Tokina AT-X 11-20 F2.8 PRO DX Aspherical 11-20mm f/2.8"},
+	161:   {"Canon EF 28-70mm f/2.8L USM or Other Lens", "Sigma 24-70mm f/2.8 EX", "Sigma 28-70mm f/2.8 EX", "Sigma 24-60mm f/2.8 EX DG", "Tamron AF 17-50mm f/2.8 Di-II LD Aspherical", "Tamron 90mm f/2.8", "Tamron SP AF 17-35mm f/2.8-4 Di LD Aspherical IF (A05)", "Tamron SP AF 28-75mm f/2.8 XR Di LD Aspherical [IF] Macro", "Tokina AT-X 24-70mm f/2.8 PRO FX (IF)"},
+	162:   {"Canon EF 200mm f/2.8L USM"},
+	163:   {"Canon EF 300mm f/4L"},
+	164:   {"Canon EF 400mm f/5.6L"},
+	165:   {"Canon EF 70-200mm f/2.8L USM"},
+	166:   {"Canon EF 70-200mm f/2.8L USM + 1.4x"},
+	167:   {"Canon EF 70-200mm f/2.8L USM + 2x"},
+	168:   {"Canon EF 28mm f/1.8 USM or Sigma Lens", "Sigma 50-100mm f/1.8 DC HSM | A"},
+	169:   {"Canon EF 17-35mm f/2.8L USM or Sigma Lens", "Sigma 18-200mm f/3.5-6.3 DC OS", "Sigma 15-30mm f/3.5-4.5 EX DG Aspherical", "Sigma 18-50mm f/2.8 Macro", "Sigma 50mm f/1.4 EX DG HSM", "Sigma 85mm f/1.4 EX DG HSM", "Sigma 30mm f/1.4 EX DC HSM", "Sigma 35mm f/1.4 DG HSM", "Sigma 35mm f/1.5 FF High-Speed Prime | 017", "Sigma 70mm f/2.8 Macro EX DG"},
+	170:   {"Canon EF 200mm f/2.8L II USM or Sigma Lens", "Sigma 300mm f/2.8 APO EX DG HSM", "Sigma 800mm f/5.6 APO EX DG HSM"},
+	171:   {"Canon EF 300mm f/4L USM"},
+	172:   {"Canon EF 400mm f/5.6L USM or Sigma Lens", "Sigma 150-600mm f/5-6.3 DG OS HSM | S", "Sigma 500mm f/4.5 APO EX DG HSM"},
+	173:   {"Canon EF 180mm Macro f/3.5L USM or Sigma Lens", "Sigma 180mm EX HSM Macro f/3.5", "Sigma APO Macro 150mm f/2.8 EX DG HSM", "Sigma 10mm f/2.8 EX DC Fisheye", "Sigma 15mm f/2.8 EX DG Diagonal Fisheye", "Venus Laowa 100mm F2.8 2X Ultra Macro APO"},
+	174:   {"Canon EF 135mm f/2L USM or Other Lens", "Sigma 70-200mm f/2.8 EX DG APO OS HSM", "Sigma 50-500mm f/4.5-6.3 APO DG OS HSM", "Sigma 150-500mm f/5-6.3 APO DG OS HSM", "Zeiss Milvus 100mm f/2 Makro", "Sigma APO 50-150mm f/2.8 EX DC OS HSM", "Sigma APO 120-300mm f/2.8 EX DG OS HSM", "Sigma 120-300mm f/2.8 DG OS HSM S013", "Sigma 120-400mm f/4.5-5.6 APO DG OS HSM", "Sigma 200-500mm f/2.8 APO EX DG"},
+	175:   {"Canon EF 400mm f/2.8L USM"},
+	176:   {"Canon EF 24-85mm f/3.5-4.5 USM"},
+	177:   {"Canon EF 300mm f/4L IS USM"},
+	178:   {"Canon EF 28-135mm f/3.5-5.6 IS"},
+	179:   {"Canon EF 24mm f/1.4L USM"},
+	180:   {"Canon EF 35mm f/1.4L USM or Other Lens", "Sigma 50mm f/1.4 DG HSM | A", "Sigma 24mm f/1.4 DG HSM | A", "Zeiss Milvus 50mm f/1.4", "Zeiss Milvus 85mm f/1.4", "Zeiss Otus 28mm f/1.4 ZE", "Sigma 24mm f/1.5 FF High-Speed Prime | 017", "Sigma 50mm f/1.5 FF High-Speed Prime | 017", "Sigma 85mm f/1.5 FF High-Speed Prime | 017", "Tokina Opera 50mm f/1.4 FF"},
+	181:   {"Canon EF 100-400mm f/4.5-5.6L IS USM + 1.4x or Sigma Lens", "Sigma 150-600mm f/5-6.3 DG OS HSM | S + 1.4x"},
+	182:   {"Canon EF 100-400mm f/4.5-5.6L IS USM + 2x or Sigma Lens", "Sigma 150-600mm f/5-6.3 DG OS HSM | S + 2x"},
+	183:   {"Canon EF 100-400mm f/4.5-5.6L IS USM or Sigma Lens", "Sigma 150mm f/2.8 EX DG OS HSM APO Macro", "Sigma 105mm f/2.8 EX DG OS HSM Macro", "Sigma 180mm f/2.8 EX DG OS HSM APO Macro", "Sigma 150-600mm f/5-6.3 DG OS HSM | C", "Sigma 150-600mm f/5-6.3 DG OS HSM | S", "Sigma 100-400mm f/5-6.3 DG OS HSM", "Sigma 180mm f/3.5 APO Macro EX DG IF HSM"},
+	184:   {"Canon EF 400mm f/2.8L USM + 2x"},
+	185:   {"Canon EF 600mm f/4L IS USM"},
+	186:   {"Canon EF 70-200mm f/4L USM"},
+	187:   {"Canon EF 70-200mm f/4L USM + 1.4x"},
+	188:   {"Canon EF 70-200mm f/4L USM + 2x"},
+	189:   {"Canon EF 70-200mm f/4L USM + 2.8x"},
+	190:   {"Canon EF 100mm f/2.8 Macro USM"},
+	191:   {"Canon EF 400mm f/4 DO IS or Sigma Lens", "Sigma 500mm f/4 DG OS HSM"},
+	193:   {"Canon EF 35-80mm f/4-5.6 USM"},
+	194:   {"Canon EF 80-200mm f/4.5-5.6 USM"},
+	195:   {"Canon EF 35-105mm f/4.5-5.6 USM"},
+	196:   {"Canon EF 75-300mm f/4-5.6 USM"},
+	197:   {"Canon EF 75-300mm f/4-5.6 IS USM or Sigma Lens", "Sigma 18-300mm f/3.5-6.3 DC Macro OS HSM"},
+	198:   {"Canon EF 50mm f/1.4 USM or Other Lens", "Zeiss Otus 55mm f/1.4 ZE", "Zeiss Otus 85mm f/1.4 ZE", "Zeiss Milvus 25mm f/1.4", "Zeiss Otus 100mm f/1.4", "Zeiss Milvus 35mm f/1.4 ZE", "Yongnuo YN 35mm f/2"},
+	199:   {"Canon EF 28-80mm f/3.5-5.6 USM"},
+	200:   {"Canon EF 75-300mm f/4-5.6 USM"},
+	201:   {"Canon EF 28-80mm f/3.5-5.6 USM"},
+	202:   {"Canon EF 28-80mm f/3.5-5.6 USM IV"},
+	208:   {"Canon EF 22-55mm f/4-5.6 USM"},
+	209:   {"Canon EF 55-200mm f/4.5-5.6"},
+	210:   {"Canon EF 28-90mm f/4-5.6 USM"},
+	211:   {"Canon EF 28-200mm f/3.5-5.6 USM"},
+	212:   {"Canon EF 28-105mm f/4-5.6 USM"},
+	213:   {"Canon EF 90-300mm f/4.5-5.6 USM or Tamron Lens", "Tamron SP 150-600mm f/5-6.3 Di VC USD (A011)", "Tamron 16-300mm f/3.5-6.3 Di II VC PZD Macro (B016)", "Tamron SP 35mm f/1.8 Di VC USD (F012)", "Tamron SP 45mm f/1.8 Di VC USD (F013)"},
+	214:   {"Canon EF-S 18-55mm f/3.5-5.6 USM"},
+	215:   {"Canon EF 55-200mm f/4.5-5.6 II USM"},
+	217:   {"Tamron AF 18-270mm f/3.5-6.3 Di II VC PZD"},
+	220:   {"Yongnuo YN 50mm f/1.8"},
+	224:   {"Canon EF 70-200mm f/2.8L IS USM"},
+	225:   {"Canon EF 70-200mm f/2.8L IS USM + 1.4x"},
+	226:   {"Canon EF 70-200mm f/2.8L IS USM + 2x"},
+	227:   {"Canon EF 70-200mm f/2.8L IS USM + 2.8x"},
+	228:   {"Canon EF 28-105mm f/3.5-4.5 USM"},
+	229:   {"Canon EF 16-35mm f/2.8L USM"},
+	230:   {"Canon EF 24-70mm f/2.8L USM"},
+	231:   {"Canon EF 17-40mm f/4L USM or Sigma Lens", "Sigma 12-24mm f/4 DG HSM A016"},
+	232:   {"Canon EF 70-300mm f/4.5-5.6 DO IS USM"},
+	233:   {"Canon EF 28-300mm f/3.5-5.6L IS USM"},
+	234:   {"Canon EF-S 17-85mm f/4-5.6 IS USM or Tokina Lens", "Tokina AT-X 12-28 PRO DX 12-28mm f/4"},
+	235:   {"Canon EF-S 10-22mm f/3.5-4.5 USM"},
+	236:   {"Canon EF-S 60mm f/2.8 Macro USM"},
+	237:   {"Canon EF 24-105mm f/4L IS USM"},
+	238:   {"Canon EF 70-300mm f/4-5.6 IS USM"},
+	239:   {"Canon EF 85mm f/1.2L II USM or Rokinon Lens", "Rokinon SP 85mm f/1.2"},
+	240:   {"Canon EF-S 17-55mm f/2.8 IS USM or Sigma Lens", "Sigma 17-50mm f/2.8 EX DC OS HSM"},
+	241:   {"Canon EF 50mm f/1.2L USM"},
+	242:   {"Canon EF 70-200mm f/4L IS USM"},
+	243:   {"Canon EF 70-200mm f/4L IS USM + 1.4x"},
+	244:   {"Canon EF 70-200mm f/4L IS USM + 2x"},
+	245:   {"Canon EF 70-200mm f/4L IS USM + 2.8x"},
+	246:   {"Canon EF 16-35mm f/2.8L II USM"},
+	247:   {"Canon EF 14mm f/2.8L II USM"},
+	248:   {"Canon EF 200mm f/2L IS USM or Sigma Lens", "Sigma 24-35mm f/2 DG HSM | A", "Sigma 135mm f/2 FF High-Speed Prime | 017", "Sigma 24-35mm f/2.2 FF Zoom | 017", "Sigma 135mm f/1.8 DG HSM A017"},
+	249:   {"Canon EF 800mm f/5.6L IS USM"},
+	250:   {"Canon EF 24mm f/1.4L II USM or Sigma Lens", "Sigma 20mm f/1.4 DG HSM | A", "Sigma 20mm f/1.5 FF High-Speed Prime | 017", "Tokina Opera 16-28mm f/2.8 FF", "Sigma 85mm f/1.4 DG HSM A016"},
+	251:   {"Canon EF 70-200mm f/2.8L IS II USM", "Canon EF 70-200mm f/2.8L IS III USM"},
+	252:   {"Canon EF 70-200mm f/2.8L IS II USM + 1.4x", "Canon EF 70-200mm f/2.8L IS III USM + 1.4x"},
+	253:   {"Canon EF 70-200mm f/2.8L IS II USM + 2x", "Canon EF 70-200mm f/2.8L IS III USM + 2x"},
+	254:   {"Canon EF 100mm f/2.8L Macro IS USM or Tamron Lens", ""},
+	255:   {"Sigma 24-105mm f/4 DG OS HSM | A or Other Lens", "Sigma 180mm f/2.8 EX DG OS HSM APO Macro", "Tamron SP 70-200mm f/2.8 Di VC USD", "Yongnuo YN 50mm f/1.8"},
+	368:   {"Sigma 14-24mm f/2.8 DG HSM | A or other Sigma Lens", "Sigma 20mm f/1.4 DG HSM | A", "Sigma 50mm f/1.4 DG HSM | A", "Sigma 40mm f/1.4 DG HSM | A", "Sigma 60-600mm f/4.5-6.3 DG OS HSM | S", "Sigma 28mm f/1.4 DG HSM | A", "Sigma 150-600mm f/5-6.3 DG OS HSM | S", "Sigma 85mm f/1.4 DG HSM | A", "Sigma 105mm f/1.4 DG HSM", "Sigma 14-24mm f/2.8 DG HSM"},
+	488:   {"Canon EF-S 15-85mm f/3.5-5.6 IS USM"},
+	489:   {"Canon EF 70-300mm f/4-5.6L IS USM"},
+	490:   {"Canon EF 8-15mm f/4L Fisheye USM"},
+	491:   {"Canon EF 300mm f/2.8L IS II USM or Tamron Lens", "Tamron SP 70-200mm f/2.8 Di VC USD G2 (A025)", "Tamron 18-400mm f/3.5-6.3 Di II VC HLD (B028)", "Tamron 100-400mm f/4.5-6.3 Di VC USD (A035)", "Tamron 70-210mm f/4 Di VC USD (A034)", "Tamron 70-210mm f/4 Di VC USD (A034) + 1.4x", "Tamron SP 24-70mm f/2.8 Di VC USD G2 (A032)"},
+	492:   {"Canon EF 400mm f/2.8L IS II USM"},
+	493:   {"Canon EF 500mm f/4L IS II USM or EF 24-105mm f4L IS USM", "Canon EF 24-105mm f/4L IS USM"},
+	494:   {"Canon EF 600mm f/4L IS II USM"},
+	495:   {"Canon EF 24-70mm f/2.8L II USM or Sigma Lens", "Sigma 24-70mm f/2.8 DG OS HSM | A"},
+	496:   {"Canon EF 200-400mm f/4L IS USM"},
+	499:   {"Canon EF 200-400mm f/4L IS USM + 1.4x"},
+	502:   {"Canon EF 28mm f/2.8 IS USM or Tamron Lens", "Tamron 35mm f/1.8 Di VC USD (F012)"},
+	503:   {"Canon EF 24mm f/2.8 IS USM"},
+	504:   {"Canon EF 24-70mm f/4L IS USM"},
+	505:   {"Canon EF 35mm f/2 IS USM"},
+	506:   {"Canon EF 400mm f/4 DO IS II USM"},
+	507:   {"Canon EF 16-35mm f/4L IS USM"},
+	508:   {"Canon EF 11-24mm f/4L USM or Tamron Lens", "Tamron 10-24mm f/3.5-4.5 Di II VC HLD (B023)"},
+	624:   {"Sigma 70-200mm f/2.8 DG OS HSM | S or other Sigma Lens", "Sigma 150-600mm f/5-6.3 | C"},
+	747:   {"Canon EF 100-400mm f/4.5-5.6L IS II USM or Tamron Lens", "Tamron SP 150-600mm f/5-6.3 Di VC USD G2"},
+	748:   {"Canon EF 100-400mm f/4.5-5.6L IS II USM + 1.4x or Tamron Lens", "Tamron 100-400mm f/4.5-6.3 Di VC USD A035E + 1.4x", "Tamron 70-210mm f/4 Di VC USD (A034) + 2x"},
+	749:   {"Tamron 100-400mm f/4.5-6.3 Di VC USD A035E + 2x"},
+	750:   {"Canon EF 35mm f/1.4L II USM or Tamron Lens", "Tamron SP 85mm f/1.8 Di VC USD (F016)", "Tamron SP 45mm f/1.8 Di VC USD (F013)"},
+	751:   {"Canon EF 16-35mm f/2.8L III USM"},
+	752:   {"Canon EF 24-105mm f/4L IS II USM"},
+	753:   {"Canon EF 85mm f/1.4L IS USM"},
+	754:   {"Canon EF 70-200mm f/4L IS II USM"},
+	757:   {"Canon EF 400mm f/2.8L IS III USM"},
+	758:   {"Canon EF 600mm f/4L IS III USM"},
+	1136:  {"Sigma 24-70mm f/2.8 DG OS HSM | A"},
+	4142:  {"Canon EF-S 18-135mm f/3.5-5.6 IS STM"},
+	4143:  {"Canon EF-M 18-55mm f/3.5-5.6 IS STM or Tamron Lens", "Tamron 18-200mm f/3.5-6.3 Di III VC"},
+	4144:  {"Canon EF 40mm f/2.8 STM"},
+	4145:  {"Canon EF-M 22mm f/2 STM"},
+	4146:  {"Canon EF-S 18-55mm f/3.5-5.6 IS STM"},
+	4147:  {"Canon EF-M 11-22mm f/4-5.6 IS STM"},
+	4148:  {"Canon EF-S 55-250mm f/4-5.6 IS STM"},
+	4149:  {"Canon EF-M 55-200mm f/4.5-6.3 IS STM"},
+	4150:  {"Canon EF-S 10-18mm f/4.5-5.6 IS STM"},
+	4152:  {"Canon EF 24-105mm f/3.5-5.6 IS STM"},
+	4153:  {"Canon EF-M 15-45mm f/3.5-6.3 IS STM"},
+	4154:  {"Canon EF-S 24mm f/2.8 STM"},
+	4155:  {"Canon EF-M 28mm f/3.5 Macro IS STM"},
+	4156:  {"Canon EF 50mm f/1.8 STM"},
+	4157:  {"Canon EF-M 18-150mm f/3.5-6.3 IS STM"},
+	4158:  {"Canon EF-S 18-55mm f/4-5.6 IS STM"},
+	4159:  {"Canon EF-M 32mm f/1.4 STM"},
+	4160:  {"Canon EF-S 35mm f/2.8 Macro IS STM"},
+	4208:  {"Sigma 56mm f/1.4 DC DN | C or other Sigma Lens", "Sigma 30mm F1.4 DC DN | C"},
+	36910: {"Canon EF 70-300mm f/4-5.6 IS II USM"},
+	36912: {"Canon EF-S 18-135mm f/3.5-5.6 IS USM"},
+	61182: {"Canon RF 50mm F1.2L USM or other Canon RF Lens", "Canon RF 24-105mm F4L IS USM", "Canon RF 28-70mm F2L USM", "Canon RF 35mm F1.8 MACRO IS STM", "Canon RF 85mm F1.2L USM", "Canon RF 85mm F1.2L USM DS", "Canon RF 24-70mm F2.8L IS USM", "Canon RF 15-35mm F2.8L IS USM", "Canon RF 24-240mm F4-6.3 IS USM", "Canon RF 70-200mm F2.8L IS USM"},
+	61491: {"Canon CN-E 14mm T3.1 L F"},
+	61492: {"Canon CN-E 24mm T1.5 L F"},
+	61494: {"Canon CN-E 85mm T1.3 L F"},
+	61495: {"Canon CN-E 135mm T2.2 L F"},
+	61496: {"Canon CN-E 35mm T1.5 L F"},
+	65535: {"n/a"},
+}
+
+// Canon ModelID Values
+var canonModelIDValues = map[uint32]models.CameraModel{
+	0x1010000:  "PowerShot A30",
+	0x1040000:  "PowerShot S300 / Digital IXUS 300 / IXY Digital 300",
+	0x1060000:  "PowerShot A20",
+	0x1080000:  "PowerShot A10",
+	0x1090000:  "PowerShot S110 / Digital IXUS v / IXY Digital 200",
+	0x1100000:  "PowerShot G2",
+	0x1110000:  "PowerShot S40",
+	0x1120000:  "PowerShot S30",
+	0x1130000:  "PowerShot A40",
+	0x1140000:  "EOS D30",
+	0x1150000:  "PowerShot A100",
+	0x1160000:  "PowerShot S200 / Digital IXUS v2 / IXY Digital 200a",
+	0x1170000:  "PowerShot A200",
+	0x1180000:  "PowerShot S330 / Digital IXUS 330 / IXY Digital 300a",
+	0x1190000:  "PowerShot G3",
+	0x1210000:  "PowerShot S45",
+	0x1230000:  "PowerShot SD100 / Digital IXUS II / IXY Digital 30",
+	0x1240000:  "PowerShot S230 / Digital IXUS v3 / IXY Digital 320",
+	0x1250000:  "PowerShot A70",
+	0x1260000:  "PowerShot A60",
+	0x1270000:  "PowerShot S400 / Digital IXUS 400 / IXY Digital 400",
+	0x1290000:  "PowerShot G5",
+	0x1300000:  "PowerShot A300",
+	0x1310000:  "PowerShot S50",
+	0x1340000:  "PowerShot A80",
+	0x1350000:  "PowerShot SD10 / Digital IXUS i / IXY Digital L",
+	0x1360000:  "PowerShot S1 IS",
+	0x1370000:  "PowerShot Pro1",
+	0x1380000:  "PowerShot S70",
+	0x1390000:  "PowerShot S60",
+	0x1400000:  "PowerShot G6",
+	0x1410000:  "PowerShot S500 / Digital IXUS 500 / IXY Digital 500",
+	0x1420000:  "PowerShot A75",
+	0x1440000:  "PowerShot SD110 / Digital IXUS IIs / IXY Digital 30a",
+	0x1450000:  "PowerShot A400",
+	0x1470000:  "PowerShot A310",
+	0x1490000:  "PowerShot A85",
+	0x1520000:  "PowerShot S410 / Digital IXUS 430 / IXY Digital 450",
+	0x1530000:  "PowerShot A95",
+	0x1540000:  "PowerShot SD300 / Digital IXUS 40 / IXY Digital 50",
+	0x1550000:  "PowerShot SD200 / Digital IXUS 30 / IXY Digital 40",
+	0x1560000:  "PowerShot A520",
+	0x1570000:  "PowerShot A510",
+	0x1590000:  "PowerShot SD20 / Digital IXUS i5 / IXY Digital L2",
+	0x1640000:  "PowerShot S2 IS",
+	0x1650000:  "PowerShot SD430 / Digital IXUS Wireless / IXY Digital Wireless",
+	0x1660000:  "PowerShot SD500 / Digital IXUS 700 / IXY Digital 600",
+	0x1668000:  "EOS D60",
+	0x1700000:  "PowerShot SD30 / Digital IXUS i Zoom / IXY Digital L3",
+	0x1740000:  "PowerShot A430",
+	0x1750000:  "PowerShot A410",
+	0x1760000:  "PowerShot S80",
+	0x1780000:  "PowerShot A620",
+	0x1790000:  "PowerShot A610",
+	0x1800000:  "PowerShot SD630 / Digital IXUS 65 / IXY Digital 80",
+	0x1810000:  "PowerShot SD450 / Digital IXUS 55 / IXY Digital 60",
+	0x1820000:  "PowerShot TX1",
+	0x1870000:  "PowerShot SD400 / Digital IXUS 50 / IXY Digital 55",
+	0x1880000:  "PowerShot A420",
+	0x1890000:  "PowerShot SD900 / Digital IXUS 900 Ti / IXY Digital 1000",
+	0x1900000:  "PowerShot SD550 / Digital IXUS 750 / IXY Digital 700",
+	0x1920000:  "PowerShot A700",
+	0x1940000:  "PowerShot SD700 IS / Digital IXUS 800 IS / IXY Digital 800 IS",
+	0x1950000:  "PowerShot S3 IS",
+	0x1960000:  "PowerShot A540",
+	0x1970000:  "PowerShot SD600 / Digital IXUS 60 / IXY Digital 70",
+	0x1980000:  "PowerShot G7",
+	0x1990000:  "PowerShot A530",
+	0x2000000:  "PowerShot SD800 IS / Digital IXUS 850 IS / IXY Digital 900 IS",
+	0x2010000:  "PowerShot SD40 / Digital IXUS i7 / IXY Digital L4",
+	0x2020000:  "PowerShot A710 IS",
+	0x2030000:  "PowerShot A640",
+	0x2040000:  "PowerShot A630",
+	0x2090000:  "PowerShot S5 IS",
+	0x2100000:  "PowerShot A460",
+	0x2120000:  "PowerShot SD850 IS / Digital IXUS 950 IS / IXY Digital 810 IS",
+	0x2130000:  "PowerShot A570 IS",
+	0x2140000:  "PowerShot A560",
+	0x2150000:  "PowerShot SD750 / Digital IXUS 75 / IXY Digital 90",
+	0x2160000:  "PowerShot SD1000 / Digital IXUS 70 / IXY Digital 10",
+	0x2180000:  "PowerShot A550",
+	0x2190000:  "PowerShot A450",
+	0x2230000:  "PowerShot G9",
+	0x2240000:  "PowerShot A650 IS",
+	0x2260000:  "PowerShot A720 IS",
+	0x2290000:  "PowerShot SX100 IS",
+	0x2300000:  "PowerShot SD950 IS / Digital IXUS 960 IS / IXY Digital 2000 IS",
+	0x2310000:  "PowerShot SD870 IS / Digital IXUS 860 IS / IXY Digital 910 IS",
+	0x2320000:  "PowerShot SD890 IS / Digital IXUS 970 IS / IXY Digital 820 IS",
+	0x2360000:  "PowerShot SD790 IS / Digital IXUS 90 IS / IXY Digital 95 IS",
+	0x2370000:  "PowerShot SD770 IS / Digital IXUS 85 IS / IXY Digital 25 IS",
+	0x2380000:  "PowerShot A590 IS",
+	0x2390000:  "PowerShot A580",
+	0x2420000:  "PowerShot A470",
+	0x2430000:  "PowerShot SD1100 IS / Digital IXUS 80 IS / IXY Digital 20 IS",
+	0x2460000:  "PowerShot SX1 IS",
+	0x2470000:  "PowerShot SX10 IS",
+	0x2480000:  "PowerShot A1000 IS",
+	0x2490000:  "PowerShot G10",
+	0x2510000:  "PowerShot A2000 IS",
+	0x2520000:  "PowerShot SX110 IS",
+	0x2530000:  "PowerShot SD990 IS / Digital IXUS 980 IS / IXY Digital 3000 IS",
+	0x2540000:  "PowerShot SD880 IS / Digital IXUS 870 IS / IXY Digital 920 IS",
+	0x2550000:  "PowerShot E1",
+	0x2560000:  "PowerShot D10",
+	0x2570000:  "PowerShot SD960 IS / Digital IXUS 110 IS / IXY Digital 510 IS",
+	0x2580000:  "PowerShot A2100 IS",
+	0x2590000:  "PowerShot A480",
+	0x2600000:  "PowerShot SX200 IS",
+	0x2610000:  "PowerShot SD970 IS / Digital IXUS 990 IS / IXY Digital 830 IS",
+	0x2620000:  "PowerShot SD780 IS / Digital IXUS 100 IS / IXY Digital 210 IS",
+	0x2630000:  "PowerShot A1100 IS",
+	0x2640000:  "PowerShot SD1200 IS / Digital IXUS 95 IS / IXY Digital 110 IS",
+	0x2700000:  "PowerShot G11",
+	0x2710000:  "PowerShot SX120 IS",
+	0x2720000:  "PowerShot S90",
+	0x2750000:  "PowerShot SX20 IS",
+	0x2760000:  "PowerShot SD980 IS / Digital IXUS 200 IS / IXY Digital 930 IS",
+	0x2770000:  "PowerShot SD940 IS / Digital IXUS 120 IS / IXY Digital 220 IS",
+	0x2800000:  "PowerShot A495",
+	0x2810000:  "PowerShot A490",
+	0x2820000:  "PowerShot A3100/A3150 IS",
+	0x2830000:  "PowerShot A3000 IS",
+	0x2840000:  "PowerShot SD1400 IS / IXUS 130 / IXY 400F",
+	0x2850000:  "PowerShot SD1300 IS / IXUS 105 / IXY 200F",
+	0x2860000:  "PowerShot SD3500 IS / IXUS 210 / IXY 10S",
+	0x2870000:  "PowerShot SX210 IS",
+	0x2880000:  "PowerShot SD4000 IS / IXUS 300 HS / IXY 30S",
+	0x2890000:  "PowerShot SD4500 IS / IXUS 1000 HS / IXY 50S",
+	0x2920000:  "PowerShot G12",
+	0x2930000:  "PowerShot SX30 IS",
+	0x2940000:  "PowerShot SX130 IS",
+	0x2950000:  "PowerShot S95",
+	0x2980000:  "PowerShot A3300 IS",
+	0x2990000:  "PowerShot A3200 IS",
+	0x3000000:  "PowerShot ELPH 500 HS / IXUS 310 HS / IXY 31S",
+	0x3010000:  "PowerShot Pro90 IS",
+	0x3010001:  "PowerShot A800",
+	0x3020000:  "PowerShot ELPH 100 HS / IXUS 115 HS / IXY 210F",
+	0x3030000:  "PowerShot SX230 HS",
+	0x3040000:  "PowerShot ELPH 300 HS / IXUS 220 HS / IXY 410F",
+	0x3050000:  "PowerShot A2200",
+	0x3060000:  "PowerShot A1200",
+	0x3070000:  "PowerShot SX220 HS",
+	0x3080000:  "PowerShot G1 X",
+	0x3090000:  "PowerShot SX150 IS",
+	0x3100000:  "PowerShot ELPH 510 HS / IXUS 1100 HS / IXY 51S",
+	0x3110000:  "PowerShot S100 (new)",
+	0x3120000:  "PowerShot ELPH 310 HS / IXUS 230 HS / IXY 600F",
+	0x3130000:  "PowerShot SX40 HS",
+	0x3140000:  "IXY 32S",
+	0x3160000:  "PowerShot A1300",
+	0x3170000:  "PowerShot A810",
+	0x3180000:  "PowerShot ELPH 320 HS / IXUS 240 HS / IXY 420F",
+	0x3190000:  "PowerShot ELPH 110 HS / IXUS 125 HS / IXY 220F",
+	0x3200000:  "PowerShot D20",
+	0x3210000:  "PowerShot A4000 IS",
+	0x3220000:  "PowerShot SX260 HS",
+	0x3230000:  "PowerShot SX240 HS",
+	0x3240000:  "PowerShot ELPH 530 HS / IXUS 510 HS / IXY 1",
+	0x3250000:  "PowerShot ELPH 520 HS / IXUS 500 HS / IXY 3",
+	0x3260000:  "PowerShot A3400 IS",
+	0x3270000:  "PowerShot A2400 IS",
+	0x3280000:  "PowerShot A2300",
+	0x3320000:  "PowerShot S100V",
+	0x3330000:  "PowerShot G15",
+	0x3340000:  "PowerShot SX50 HS",
+	0x3350000:  "PowerShot SX160 IS",
+	0x3360000:  "PowerShot S110 (new)",
+	0x3370000:  "PowerShot SX500 IS",
+	0x3380000:  "PowerShot N",
+	0x3390000:  "IXUS 245 HS / IXY 430F",
+	0x3400000:  "PowerShot SX280 HS",
+	0x3410000:  "PowerShot SX270 HS",
+	0x3420000:  "PowerShot A3500 IS",
+	0x3430000:  "PowerShot A2600",
+	0x3440000:  "PowerShot SX275 HS",
+	0x3450000:  "PowerShot A1400",
+	0x3460000:  "PowerShot ELPH 130 IS / IXUS 140 / IXY 110F",
+	0x3470000:  "PowerShot ELPH 115/120 IS / IXUS 132/135 / IXY 90F/100F",
+	0x3490000:  "PowerShot ELPH 330 HS / IXUS 255 HS / IXY 610F",
+	0x3510000:  "PowerShot A2500",
+	0x3540000:  "PowerShot G16",
+	0x3550000:  "PowerShot S120",
+	0x3560000:  "PowerShot SX170 IS",
+	0x3580000:  "PowerShot SX510 HS",
+	0x3590000:  "PowerShot S200 (new)",
+	0x3600000:  "IXY 620F",
+	0x3610000:  "PowerShot N100",
+	0x3640000:  "PowerShot G1 X Mark II",
+	0x3650000:  "PowerShot D30",
+	0x3660000:  "PowerShot SX700 HS",
+	0x3670000:  "PowerShot SX600 HS",
+	0x3680000:  "PowerShot ELPH 140 IS / IXUS 150 / IXY 130",
+	0x3690000:  "PowerShot ELPH 135 / IXUS 145 / IXY 120",
+	0x3700000:  "PowerShot ELPH 340 HS / IXUS 265 HS / IXY 630",
+	0x3710000:  "PowerShot ELPH 150 IS / IXUS 155 / IXY 140",
+	0x3740000:  "EOS M3",
+	0x3750000:  "PowerShot SX60 HS",
+	0x3760000:  "PowerShot SX520 HS",
+	0x3770000:  "PowerShot SX400 IS",
+	0x3780000:  "PowerShot G7 X",
+	0x3790000:  "PowerShot N2",
+	0x3800000:  "PowerShot SX530 HS",
+	0x3820000:  "PowerShot SX710 HS",
+	0x3830000:  "PowerShot SX610 HS",
+	0x3840000:  "EOS M10",
+	0x3850000:  "PowerShot G3 X",
+	0x3860000:  "PowerShot ELPH 165 HS / IXUS 165 / IXY 160",
+	0x3870000:  "PowerShot ELPH 160 / IXUS 160",
+	0x3880000:  "PowerShot ELPH 350 HS / IXUS 275 HS / IXY 640",
+	0x3890000:  "PowerShot ELPH 170 IS / IXUS 170",
+	0x3910000:  "PowerShot SX410 IS",
+	0x3930000:  "PowerShot G9 X",
+	0x3940000:  "EOS M5",
+	0x3950000:  "PowerShot G5 X",
+	0x3970000:  "PowerShot G7 X Mark II",
+	0x3980000:  "EOS M100",
+	0x3990000:  "PowerShot ELPH 360 HS / IXUS 285 HS / IXY 650",
+	0x4007:     "DC19/DC21/DC22",
+	0x4010000:  "PowerShot SX540 HS",
+	0x4020000:  "PowerShot SX420 IS",
+	0x4030000:  "PowerShot ELPH 190 IS / IXUS 180 / IXY 190",
+	0x4040000:  "PowerShot G1",
+	0x4040001:  "PowerShot ELPH 180 IS / IXUS 175 / IXY 180",
+	0x4050000:  "PowerShot SX720 HS",
+	0x4060000:  "PowerShot SX620 HS",
+	0x4070000:  "EOS M6",
+	0x4100000:  "PowerShot G9 X Mark II",
+	0x412:      "EOS M50 / Kiss M",
+	0x4150000:  "PowerShot ELPH 185 / IXUS 185 / IXY 200",
+	0x4160000:  "PowerShot SX430 IS",
+	0x4170000:  "PowerShot SX730 HS",
+	0x4180000:  "PowerShot G1 X Mark III",
+	0x6040000:  "PowerShot S100 / Digital IXUS / IXY Digital",
+	0x80000001: "EOS-1D",
+	0x80000167: "EOS-1DS",
+	0x80000168: "EOS 10D",
+	0x80000169: "EOS-1D Mark III",
+	0x80000170: "EOS Digital Rebel / 300D / Kiss Digital",
+	0x80000174: "EOS-1D Mark II",
+	0x80000175: "EOS 20D",
+	0x80000176: "EOS Digital Rebel XSi / 450D / Kiss X2",
+	0x80000188: "EOS-1Ds Mark II",
+	0x80000189: "EOS Digital Rebel XT / 350D / Kiss Digital N",
+	0x80000190: "EOS 40D",
+	0x80000213: "EOS 5D",
+	0x80000215: "EOS-1Ds Mark III",
+	0x80000218: "EOS 5D Mark II",
+	0x80000219: "WFT-E1",
+	0x80000232: "EOS-1D Mark II N",
+	0x80000234: "EOS 30D",
+	0x80000236: "EOS Digital Rebel XTi / 400D / Kiss Digital X",
+	0x80000241: "WFT-E2",
+	0x80000246: "WFT-E3",
+	0x80000250: "EOS 7D",
+	0x80000252: "EOS Rebel T1i / 500D / Kiss X3",
+	0x80000254: "EOS Rebel XS / 1000D / Kiss F",
+	0x80000261: "EOS 50D",
+	0x80000269: "EOS-1D X",
+	0x80000270: "EOS Rebel T2i / 550D / Kiss X4",
+	0x80000271: "WFT-E4",
+	0x80000273: "WFT-E5",
+	0x80000281: "EOS-1D Mark IV",
+	0x80000285: "EOS 5D Mark III",
+	0x80000286: "EOS Rebel T3i / 600D / Kiss X5",
+	0x80000287: "EOS 60D",
+	0x80000288: "EOS Rebel T3 / 1100D / Kiss X50",
+	0x80000289: "EOS 7D Mark II",
+	0x80000297: "WFT-E2 II",
+	0x80000298: "WFT-E4 II",
+	0x80000301: "EOS Rebel T4i / 650D / Kiss X6i",
+	0x80000302: "EOS 6D",
+	0x80000324: "EOS-1D C",
+	0x80000325: "EOS 70D",
+	0x80000326: "EOS Rebel T5i / 700D / Kiss X7i",
+	0x80000327: "EOS Rebel T5 / 1200D / Kiss X70 / Hi",
+	0x80000328: "EOS-1D X Mark II",
+	0x80000331: "EOS M",
+	0x80000346: "EOS Rebel SL1 / 100D / Kiss X7",
+	0x80000347: "EOS Rebel T6s / 760D / 8000D",
+	0x80000349: "EOS 5D Mark IV",
+	0x80000350: "EOS 80D",
+	0x80000355: "EOS M2",
+	0x80000382: "EOS 5DS",
+	0x80000393: "EOS Rebel T6i / 750D / Kiss X8i",
+	0x80000401: "EOS 5DS R",
+	0x80000404: "EOS Rebel T6 / 1300D / Kiss X80",
+	0x80000405: "EOS Rebel T7i / 800D / Kiss X9i",
+	0x80000406: "EOS 6D Mark II",
+	0x80000408: "EOS 77D / 9000D",
+	0x80000417: "EOS Rebel SL2 / 200D / Kiss X9",
+	0x80000421: "EOS R5",
+	0x80000422: "EOS Rebel T100 / 4000D / 3000D",
+	0x80000424: "EOS R",
+	0x80000428: "EOS-1D X Mark III",
+	0x80000432: "EOS Rebel T7 / 2000D / 1500D / Kiss X90",
+	0x80000433: "EOS RP",
+	0x80000435: "EOS Rebel T8i / 850D / X10i",
+	0x80000436: "EOS SL3 / 250D / Kiss X10",
+	0x80000437: "EOS 90D",
+	0x80000450: "EOS R3",
+	0x80000453: "EOS R6",
+	0x80000464: "EOS R7",
+	0x80000465: "EOS R10",
+	0x80000467: "PowerShot ZOOM",
+	0x80000468: "EOS M50 Mark II / Kiss M2",
+	0x80000480: "EOS R50",
+	0x80000481: "EOS R6 Mark II",
+	0x80000487: "EOS R8",
+	0x80000491: "PowerShot V10",
+	0x80000495: "EOS R1",
+	0x80000496: "R5 Mark II",
+	0x80000498: "EOS R100",
+	0x80000520: "EOS D2000C",
+	0x80000560: "EOS D6000C",
+	0x801:      "PowerShot SX740 HS",
+	0x804:      "PowerShot G5 X Mark II",
+	0x805:      "PowerShot SX70 HS",
+	0x808:      "PowerShot G7 X Mark III",
+	0x811:      "EOS M6 Mark II",
+	0x812:      "EOS M200",
+}
@@ -1,73 +1,108 @@
 package mknote
 
 import (
-	"github.com/evanoberholster/exiftools/exif"
+	"bytes"
+	"fmt"
+
+	"github.com/rpajarola/exiftools/exif"
+	"github.com/rpajarola/exiftools/models"
+	"github.com/rpajarola/exiftools/tiff"
 )
 
-// AdobeDNG is an exif.Parse for DNG subIfds.
+// AdobeDNG is a MakerNoteParser for the SubIFDs (full-size raw data,
+// preview, and thumbnail) a DNG file's IFD0 points to. These aren't
+// vendor-specific the way a maker note is - Matches accepts every file,
+// and Parse itself no-ops when SubIFDsPointer isn't present.
 var AdobeDNG = &adobeDNG{}
+
 type adobeDNG struct{}
 
-// Parse decodes all Adobe DNG subIFDS found in x and adds it to x.
-func (_ *adobeDNG) Parse(x *exif.Exif) error {
-	m, err := x.Get(exif.SubIfdsPointer)
+// Matches always returns true: DNG SubIFDs are addressed by a standard
+// TIFF tag, not a Make/Model-specific maker note, and Parse's own
+// SubIFDsPointer check is what actually decides whether there's anything
+// to do for a given file.
+func (*adobeDNG) Matches(make, model string) bool {
+	return true
+}
+
+// Parse decodes all Adobe DNG SubIFDs found in x and adds them to x.
+func (*adobeDNG) Parse(x *exif.Exif) error {
+	m, err := x.Get(models.SubIfdsPointer)
 	if err != nil {
 		return nil
 	}
-	if !(m.Count > 0) {
+	if m.Count == 0 {
 		return nil
 	}
-	subIfds := []map[uint16]exif.FieldName{
+	subIfds := []map[uint16]models.FieldName{
 		SubIFD0Fields,
 		SubIFD1Fields,
 		SubIFD2Fields,
 	}
 	r := bytes.NewReader(x.Raw)
 	for i, sub := range subIfds {
+		if uint32(i) >= m.Count {
+			break
+		}
 		offset, err := m.Int64(i)
 		if err != nil {
 			return nil
 		}
-		_, err = r.Seek(offset, 0)
-		if err != nil {
-			return fmt.Errorf("exif: seek to sub-IFD %s failed: %v", exif.SubIfdsPointer, err)
+		if _, err := r.Seek(offset, 0); err != nil {
+			return fmt.Errorf("exif: seek to sub-IFD %s failed: %v", models.SubIfdsPointer, err)
 		}
 		subDir, _, err := tiff.DecodeDir(r, x.Tiff.Order)
 		if err != nil {
-			return fmt.Errorf("exif: sub-IFD %s decode failed: %v", exif.SubIfdsPointer, err)
+			return fmt.Errorf("exif: sub-IFD %s decode failed: %v", models.SubIfdsPointer, err)
 		}
 		x.LoadTags(subDir, sub, false)
 	}
-
 	return nil
 }
 
-// PreviewImageTags
+// PreviewImageTags for exif.Exif.ExtractPreviews/LargestPreview: SubIfd0
+// and SubIfd1 are (in increasing-resolution order, by DNG convention) the
+// thumbnail and preview images, and SubIfd2JpegFromRaw is the full-size
+// "JpgFromRaw" embedded in some DNGs in place of (or alongside) raw data.
 var (
-	SubIfd0PreviewImage = exif.NewPreviewImageTag(subIfdMap("SubIfd0", PreviewImageStart), subIfdMap("SubIfd0", PreviewImageLength), subIfdMap("SubIfd0", Compression))
-	SubIfd1PreviewImage = exif.NewPreviewImageTag(subIfdMap("SubIfd1", PreviewImageStart), subIfdMap("SubIfd1", PreviewImageLength), subIfdMap("SubIfd1", Compression))
-	SubIfd2JpegFromRaw  = exif.NewPreviewImageTag(subIfdMap("SubIfd2", JpgFromRawStart), subIfdMap("SubIfd2", JpgFromRawLength), subIfdMap("SubIfd2", Compression))
+	SubIfd0PreviewImage = exif.NewPreviewImageTagFull(
+		subIfdMap("SubIfd0", PreviewImageStart), subIfdMap("SubIfd0", PreviewImageLength), subIfdMap("SubIfd0", Compression),
+		subIfdMap("SubIfd0", ImageWidth), subIfdMap("SubIfd0", ImageLength), subIfdMap("SubIfd0", PreviewColorSpace), subIfdMap("SubIfd0", PreviewDateTime),
+	)
+	SubIfd1PreviewImage = exif.NewPreviewImageTagFull(
+		subIfdMap("SubIfd1", PreviewImageStart), subIfdMap("SubIfd1", PreviewImageLength), subIfdMap("SubIfd1", Compression),
+		subIfdMap("SubIfd1", ImageWidth), subIfdMap("SubIfd1", ImageLength), subIfdMap("SubIfd1", PreviewColorSpace), subIfdMap("SubIfd1", PreviewDateTime),
+	)
+	SubIfd2JpegFromRaw = exif.NewPreviewImageTagFull(
+		subIfdMap("SubIfd2", JpgFromRawStart), subIfdMap("SubIfd2", JpgFromRawLength), subIfdMap("SubIfd2", Compression),
+		subIfdMap("SubIfd2", ImageWidth), subIfdMap("SubIfd2", ImageLength), subIfdMap("SubIfd2", PreviewColorSpace), subIfdMap("SubIfd2", PreviewDateTime),
+	)
+
+	// DNGPreviewImageTags is every SubIFD preview candidate above,
+	// ready to pass to ExtractPreviews/LargestPreview alongside (or
+	// instead of) a caller's own.
+	DNGPreviewImageTags = []exif.PreviewImageTag{SubIfd0PreviewImage, SubIfd1PreviewImage, SubIfd2JpegFromRaw}
 )
 
 // SubIfd-specific fields
 var (
-	SubfileType        exif.FieldName = "SubfileType"
-	ImageWidth         exif.FieldName = "Width"
-	ImageLength        exif.FieldName = "ImageLength"
-	Compression        exif.FieldName = "Compression"
-	JpgFromRawStart    exif.FieldName = "JpgFromRawStart"
-	JpgFromRawLength   exif.FieldName = "JpgFromRawLength"
-	PreviewImageStart  exif.FieldName = "PreviewImageStart"
-	PreviewImageLength exif.FieldName = "PreviewImageLength"
-	PreviewColorSpace  exif.FieldName = "PreviewColorSpace"
-	PreviewDateTime    exif.FieldName = "PreviewDateTime"
+	SubfileType        models.FieldName = "SubfileType"
+	ImageWidth         models.FieldName = "Width"
+	ImageLength        models.FieldName = "ImageLength"
+	Compression        models.FieldName = "Compression"
+	JpgFromRawStart    models.FieldName = "JpgFromRawStart"
+	JpgFromRawLength   models.FieldName = "JpgFromRawLength"
+	PreviewImageStart  models.FieldName = "PreviewImageStart"
+	PreviewImageLength models.FieldName = "PreviewImageLength"
+	PreviewColorSpace  models.FieldName = "PreviewColorSpace"
+	PreviewDateTime    models.FieldName = "PreviewDateTime"
 )
 
-func subIfdMap(ifd string, fn exif.FieldName) exif.FieldName {
-	return exif.FieldName(ifd + "." + string(fn))
+func subIfdMap(ifd string, fn models.FieldName) models.FieldName {
+	return models.FieldName(ifd + "." + string(fn))
 }
 
-var SubIFD0Fields = map[uint16]exif.FieldName{
+var SubIFD0Fields = map[uint16]models.FieldName{
 	0x00fe: subIfdMap("SubIfd0", SubfileType),
 	0x0100: subIfdMap("SubIfd0", ImageWidth),
 	0x0101: subIfdMap("SubIfd0", ImageLength),
@@ -78,7 +113,7 @@ var SubIFD0Fields = map[uint16]exif.FieldName{
 	0xc71b: subIfdMap("SubIfd0", PreviewDateTime),
 }
 
-var SubIFD1Fields = map[uint16]exif.FieldName{
+var SubIFD1Fields = map[uint16]models.FieldName{
 	0x00fe: subIfdMap("SubIfd1", SubfileType),
 	0x0100: subIfdMap("SubIfd1", ImageWidth),
 	0x0101: subIfdMap("SubIfd1", ImageLength),
@@ -89,7 +124,7 @@ var SubIFD1Fields = map[uint16]exif.FieldName{
 	0xc71b: subIfdMap("SubIfd1", PreviewDateTime),
 }
 
-var SubIFD2Fields = map[uint16]exif.FieldName{
+var SubIFD2Fields = map[uint16]models.FieldName{
 	0x00fe: subIfdMap("SubIfd2", SubfileType),
 	0x0100: subIfdMap("SubIfd2", ImageWidth),
 	0x0101: subIfdMap("SubIfd2", ImageLength),
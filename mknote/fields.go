@@ -0,0 +1,32 @@
+package mknote
+
+import (
+	"github.com/rpajarola/exiftools/models"
+)
+
+// Maker-note fields shared across more than one vendor's parser (Panorama,
+// FirmwareVersion, ModelID, ...), as opposed to the vendor-prefixed fields
+// (CanonShotInfo, NikonShotInfo, ...) declared alongside each parser.
+const (
+	Panorama                   models.FieldName = "Panorama"
+	FirmwareVersion            models.FieldName = "FirmwareVersion"
+	FileNumber                 models.FieldName = "FileNumber"
+	OwnerName                  models.FieldName = "OwnerName"
+	CameraInfo                 models.FieldName = "CameraInfo"
+	CustomFunctions            models.FieldName = "CustomFunctions"
+	ModelID                    models.FieldName = "ModelID"
+	PictureInfo                models.FieldName = "PictureInfo"
+	ThumbnailImageValidArea    models.FieldName = "ThumbnailImageValidArea"
+	SerialNumberFormat         models.FieldName = "SerialNumberFormat"
+	SuperMacro                 models.FieldName = "SuperMacro"
+	OriginalDecisionDataOffset models.FieldName = "OriginalDecisionDataOffset"
+	WhiteBalanceTable          models.FieldName = "WhiteBalanceTable"
+	LensModel                  models.FieldName = "LensModel"
+	InternalSerialNumber       models.FieldName = "InternalSerialNumber"
+	DustRemovalData            models.FieldName = "DustRemovalData"
+	ProcessingInfo             models.FieldName = "ProcessingInfo"
+	MeasuredColor              models.FieldName = "MeasuredColor"
+	VRDOffset                  models.FieldName = "VRDOffset"
+	SensorInfo                 models.FieldName = "SensorInfo"
+	ColorData                  models.FieldName = "ColorData"
+)
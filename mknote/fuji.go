@@ -0,0 +1,105 @@
+package mknote
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/rpajarola/exiftools/exif"
+	"github.com/rpajarola/exiftools/models"
+	"github.com/rpajarola/exiftools/tiff"
+)
+
+// Fujifilm is a MakerNoteParser for Fujifilm makernote data.
+var Fujifilm = &fujifilm{}
+
+type fujifilm struct{}
+
+// Matches reports whether make identifies a Fujifilm body.
+func (*fujifilm) Matches(make, model string) bool {
+	return make == "FUJIFILM"
+}
+
+// Fujifilm-specific fields
+var (
+	FujifilmQuality          models.FieldName = "Fujifilm.Quality"
+	FujifilmSharpness        models.FieldName = "Fujifilm.Sharpness"
+	FujifilmWhiteBalance     models.FieldName = "Fujifilm.WhiteBalance"
+	FujifilmColorSaturation  models.FieldName = "Fujifilm.ColorSaturation"
+	FujifilmFilmMode         models.FieldName = "Fujifilm.FilmMode"
+	FujifilmDynamicRange     models.FieldName = "Fujifilm.DynamicRange"
+	FujifilmFocusMode        models.FieldName = "Fujifilm.FocusMode"
+	FujifilmSerialNumber     models.FieldName = "Fujifilm.SerialNumber"
+	FujifilmFaceElementCount models.FieldName = "Fujifilm.FaceElementCount"
+	FujifilmPictureMode      models.FieldName = "Fujifilm.PictureMode"
+	FujifilmWhiteBalanceTune models.FieldName = "Fujifilm.WhiteBalanceFineTune"
+)
+
+var makerNoteFujifilmFields = map[uint16]models.FieldName{
+	0x1000: FujifilmQuality,
+	0x1001: FujifilmSharpness,
+	0x1002: FujifilmWhiteBalance,
+	0x1003: FujifilmColorSaturation,
+	0x1210: FujifilmWhiteBalanceTune,
+	0x1401: FujifilmDynamicRange,
+	0x1021: FujifilmFocusMode,
+	0x1031: FujifilmPictureMode, // shooting mode, e.g. Program/Aperture/Shutter priority
+	0x1400: FujifilmFilmMode,
+	0x0010: FujifilmSerialNumber,
+	0x4100: FujifilmFaceElementCount,
+}
+
+// fujifilmSignature is the 8-byte identifier at the start of every
+// Fujifilm maker note, followed by a 4-byte little-endian offset (always
+// little-endian, independent of the parent TIFF's byte order) to the
+// maker note's own IFD0, relative to the start of the maker note itself.
+var fujifilmSignature = []byte("FUJIFILM")
+
+// Parse decodes all Fujifilm makernote data found in x and adds it to x.
+//
+// Unlike Nikon/Sony, Fujifilm doesn't expose a lens ID or shutter count
+// as a plain maker-note tag: lens identification comes from the
+// standard Exif LensModel tag modern X-series bodies already fill in,
+// and shutter count isn't available without vendor tooling.
+func (*fujifilm) Parse(x *exif.Exif) error {
+	if x.SkipMakerNote() {
+		return nil
+	}
+	m, err := x.Get(models.MakerNote)
+	if err != nil {
+		return nil
+	}
+	if len(m.Val) < 12 || !bytes.Equal(m.Val[:8], fujifilmSignature) {
+		return nil
+	}
+
+	offset := binary.LittleEndian.Uint32(m.Val[8:12])
+	fReader := bytes.NewReader(m.Val)
+	if _, err := fReader.Seek(int64(offset), 0); err != nil {
+		return err
+	}
+
+	mkNotesDir, _, err := tiff.DecodeDir(fReader, binary.LittleEndian)
+	if err != nil {
+		return err
+	}
+	x.LoadTags(mkNotesDir, makerNoteFujifilmFields, false)
+	return nil
+}
+
+// Encode rebuilds the Fujifilm maker-note sub-IFD from whatever
+// makerNoteFujifilmFields are currently set on x.
+func (*fujifilm) Encode(x *exif.Exif) (*tiff.Dir, error) {
+	dir := &tiff.Dir{}
+	for id, name := range makerNoteFujifilmFields {
+		tag, err := x.Get(name)
+		if err != nil {
+			continue
+		}
+		tag.Id = id
+		dir.Tags = append(dir.Tags, tag)
+	}
+	if len(dir.Tags) == 0 {
+		return nil, nil
+	}
+	return dir, nil
+}
@@ -9,13 +9,62 @@ import (
 	"github.com/rpajarola/exiftools/tiff"
 )
 
+// MakerNoteParser is a mknote parser that declares which EXIF Make/Model
+// combinations it understands, so the registry can skip parsers that
+// don't apply to a given file instead of relying on each Parse method to
+// bail out after inspecting exif.Make/exif.Model itself.
+type MakerNoteParser interface {
+	exif.Parser
+	// Matches reports whether this parser can decode maker-note data
+	// from a file with the given EXIF Make/Model.
+	Matches(make, model string) bool
+}
+
 var (
-	// All is a list of all available makernote parsers
-	All = []exif.Parser{Apple, Canon, NikonV3, AdobeDNG, Sony}
+	// All holds every registered MakerNoteParser, in registration
+	// order. Built-in parsers are listed here directly; Register exists
+	// for makernote implementations defined outside this package.
+	All = []MakerNoteParser{Apple, Canon, NikonType1, NikonV3, Sony, Fujifilm, Olympus, Panasonic, AdobeDNG}
 )
 
+// Register adds parser to All.
+func Register(parser MakerNoteParser) {
+	All = append(All, parser)
+}
+
 func init() {
-	exif.RegisterParsers(All...)
+	exif.RegisterParsers(dispatcher{})
+}
+
+// dispatcher is the single exif.Parser mknote registers with the exif
+// package. It calls every MakerNoteParser in All whose Matches accepts
+// x's Make/Model, so adding a parser to All is enough to wire it into
+// Decode without touching this type.
+type dispatcher struct{}
+
+func (dispatcher) Parse(x *exif.Exif) error {
+	make, model := makeModel(x)
+	for _, p := range All {
+		if !p.Matches(make, model) {
+			continue
+		}
+		if err := p.Parse(x); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// makeModel reads x's EXIF Make/Model tags, returning "" for either that
+// isn't present or isn't a string.
+func makeModel(x *exif.Exif) (make, model string) {
+	if tag, err := x.Get(exif.Make); err == nil {
+		make, _ = tag.StringVal()
+	}
+	if tag, err := x.Get(exif.Model); err == nil {
+		model, _ = tag.StringVal()
+	}
+	return make, model
 }
 
 func loadSubDir(x *exif.Exif, r *bytes.Reader, ptr exif.FieldName, fieldMap map[uint16]exif.FieldName) error {
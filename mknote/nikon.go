@@ -0,0 +1,210 @@
+package mknote
+
+import (
+	"bytes"
+
+	"github.com/rpajarola/exiftools/exif"
+	"github.com/rpajarola/exiftools/models"
+	"github.com/rpajarola/exiftools/tiff"
+)
+
+// NikonV3 is a MakerNoteParser for Nikon "type 2"/"type 3" makernote
+// data (the "Nikon\x00"-prefixed format with an embedded TIFF header),
+// used by essentially all modern (D-series, Z-series, Coolpix) bodies.
+// Some binary sub-blocks this format carries (ShotInfo, ColorBalance)
+// are themselves encrypted with a key derived from the body's serial
+// number and shutter count; NikonV3 doesn't attempt to decrypt them; it
+// simply doesn't map their tag IDs into makerNoteNikonFields, so they're
+// skipped rather than surfaced as garbled fields.
+var NikonV3 = &nikon{}
+
+type nikon struct{}
+
+// Matches reports whether make identifies a Nikon body and m.Val carries
+// the "Nikon\x00"-prefixed format NikonV3 decodes, as opposed to the
+// headerless "type 1" format NikonType1 handles.
+func (*nikon) Matches(make, model string) bool {
+	return make == "NIKON CORPORATION" || make == "NIKON"
+}
+
+// Nikon-specific fields
+var (
+	NikonISOSpeed      models.FieldName = "Nikon.ISOSpeed"
+	NikonColorMode     models.FieldName = "Nikon.ColorMode"
+	NikonQuality       models.FieldName = "Nikon.Quality"
+	NikonWhiteBalance  models.FieldName = "Nikon.WhiteBalance"
+	NikonSharpening    models.FieldName = "Nikon.Sharpening"
+	NikonFocusMode     models.FieldName = "Nikon.FocusMode"
+	NikonFlashSetting  models.FieldName = "Nikon.FlashSetting"
+	NikonISOSelection  models.FieldName = "Nikon.ISOSelection"
+	NikonShotInfo      models.FieldName = "Nikon.ShotInfo"
+	NikonVRInfo        models.FieldName = "Nikon.VRInfo"
+	NikonLensType      models.FieldName = "Nikon.LensType"
+	NikonLens          models.FieldName = "Nikon.Lens"
+	NikonFocusDistance models.FieldName = "Nikon.FocusDistance"
+	NikonShutterCount  models.FieldName = "Nikon.ShutterCount"
+	NikonSerialNumber  models.FieldName = "Nikon.SerialNumber"
+	NikonPreviewIFD    models.FieldName = "Nikon.PreviewIFD"
+	NikonShootingMode  models.FieldName = "Nikon.ShootingMode"
+)
+
+var makerNoteNikonFields = map[uint16]models.FieldName{
+	0x0002: NikonISOSpeed,
+	0x0003: NikonColorMode,
+	0x0004: NikonQuality,
+	0x0005: NikonWhiteBalance,
+	0x0006: NikonSharpening,
+	0x0007: NikonFocusMode,
+	0x0008: NikonFlashSetting,
+	0x0009: NikonISOSelection,
+	0x000a: NikonVRInfo,
+	0x0011: NikonPreviewIFD,
+	0x0083: NikonLensType,
+	0x0084: NikonLens,
+	0x0089: NikonShootingMode,
+	0x0093: NikonFocusDistance,
+	0x00a7: NikonShutterCount,
+	0x001d: NikonSerialNumber,
+}
+
+// Nikon-specific preview fields, populated by parseNikonPreviewIFD below
+// from the sub-IFD NikonPreviewIFD points to, rather than loaded
+// directly as part of the main maker-note IFD.
+var (
+	NikonPreviewImageStart  models.FieldName = "Nikon.PreviewImageStart"
+	NikonPreviewImageLength models.FieldName = "Nikon.PreviewImageLength"
+)
+
+// NikonPreviewImage is a PreviewImageTag candidate for
+// exif.Exif.ExtractPreviewImage, sourced from Nikon's PreviewIFD.
+var NikonPreviewImage = exif.NewPreviewImageTag(NikonPreviewImageStart, NikonPreviewImageLength, models.FieldName("None"))
+
+// nikonPreviewIFDFields maps PreviewIFD's own entries: the same baseline
+// JPEGInterchangeFormat(Length) tags IFD1 uses for a file's own
+// thumbnail, reused here for Nikon's in-camera preview.
+var nikonPreviewIFDFields = map[uint16]models.FieldName{
+	0x0201: NikonPreviewImageStart,
+	0x0202: NikonPreviewImageLength,
+}
+
+// Parse decodes all Nikon makernote data found in x and adds it to x.
+//
+// Unlike Apple/Canon/Sony, a Nikon "type 3" maker note embeds its own
+// complete TIFF structure (its own byte-order marker and IFD0 offset)
+// starting 10 bytes after the "Nikon\x00" signature and version bytes,
+// rather than being a bare IFD sharing the parent TIFF's byte order.
+func (*nikon) Parse(x *exif.Exif) error {
+	if x.SkipMakerNote() {
+		return nil
+	}
+	m, err := x.Get(models.MakerNote)
+	if err != nil {
+		return nil
+	}
+	if len(m.Val) < 10 || !bytes.Equal(m.Val[:6], []byte("Nikon\x00")) {
+		return nil
+	}
+
+	nReader := bytes.NewReader(m.Val[10:])
+	subTiff, err := tiff.Decode(nReader)
+	if err != nil {
+		return err
+	}
+	if len(subTiff.Dirs) == 0 {
+		return nil
+	}
+	x.LoadTags(subTiff.Dirs[0], makerNoteNikonFields, false)
+
+	// PreviewIFD (tag 0x0011) is a pointer into this same embedded TIFF
+	// structure, holding a small second IFD with the preview JPEG's own
+	// JPEGInterchangeFormat(Length) pair, exactly like a file's IFD1.
+	if ptr, err := x.Get(NikonPreviewIFD); err == nil {
+		if offset, err := ptr.Int(0); err == nil {
+			pReader := bytes.NewReader(m.Val[10:])
+			pReader.Seek(int64(offset), 0)
+			previewDir, _, err := tiff.DecodeDir(pReader, subTiff.Order)
+			if err == nil {
+				x.LoadTags(previewDir, nikonPreviewIFDFields, false)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode rebuilds the Nikon maker-note sub-IFD from whatever
+// makerNoteNikonFields are currently set on x.
+func (*nikon) Encode(x *exif.Exif) (*tiff.Dir, error) {
+	dir := &tiff.Dir{}
+	for id, name := range makerNoteNikonFields {
+		tag, err := x.Get(name)
+		if err != nil {
+			continue
+		}
+		tag.Id = id
+		dir.Tags = append(dir.Tags, tag)
+	}
+	if len(dir.Tags) == 0 {
+		return nil, nil
+	}
+	return dir, nil
+}
+
+// NikonType1 is a MakerNoteParser for Nikon "type 1" makernote data, the
+// headerless format used by older Coolpix models: a bare IFD with no
+// "Nikon" signature at all, sharing the parent TIFF's byte order, the
+// same shape as Canon's maker note.
+var NikonType1 = &nikonType1{}
+
+type nikonType1 struct{}
+
+// Matches reports whether make identifies a Nikon body whose maker note
+// lacks the "Nikon\x00" signature NikonV3 requires.
+func (*nikonType1) Matches(make, model string) bool {
+	return make == "NIKON CORPORATION" || make == "NIKON"
+}
+
+// Parse decodes a "type 1" Nikon makernote found in x and adds it to x.
+func (*nikonType1) Parse(x *exif.Exif) error {
+	if x.SkipMakerNote() {
+		return nil
+	}
+	m, err := x.Get(models.MakerNote)
+	if err != nil {
+		return nil
+	}
+	if bytes.HasPrefix(m.Val, []byte("Nikon\x00")) {
+		return nil // NikonV3's format, not ours
+	}
+
+	// Like Canon's maker note, type 1 is a single IFD directory with no
+	// header; reader offsets need to be w.r.t. the original tiff
+	// structure.
+	nReader := bytes.NewReader(append(make([]byte, m.ValOffset), m.Val...))
+	nReader.Seek(int64(m.ValOffset), 0)
+
+	mkNotesDir, _, err := tiff.DecodeDir(nReader, x.Tiff.Order)
+	if err != nil {
+		return err
+	}
+	x.LoadTags(mkNotesDir, makerNoteNikonFields, false)
+	return nil
+}
+
+// Encode rebuilds the Nikon "type 1" maker-note IFD from whatever
+// makerNoteNikonFields are currently set on x.
+func (*nikonType1) Encode(x *exif.Exif) (*tiff.Dir, error) {
+	dir := &tiff.Dir{}
+	for id, name := range makerNoteNikonFields {
+		tag, err := x.Get(name)
+		if err != nil {
+			continue
+		}
+		tag.Id = id
+		dir.Tags = append(dir.Tags, tag)
+	}
+	if len(dir.Tags) == 0 {
+		return nil, nil
+	}
+	return dir, nil
+}
@@ -0,0 +1,98 @@
+package mknote
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/rpajarola/exiftools/exif"
+	"github.com/rpajarola/exiftools/models"
+	"github.com/rpajarola/exiftools/tiff"
+)
+
+// Olympus is a MakerNoteParser for Olympus/OM System makernote data. It
+// recognizes both the old "OLYMP\x00" signature (pre-E-1 models, a bare
+// IFD following the signature and two version bytes, sharing the parent
+// TIFF's byte order) and the newer "OLYMPUS\x00II" signature (E-1
+// onward, always little-endian regardless of the parent TIFF, with two
+// further header bytes before its IFD begins).
+var Olympus = &olympus{}
+
+type olympus struct{}
+
+var (
+	olympusOldSignature = []byte("OLYMP\x00")
+	olympusNewSignature = []byte("OLYMPUS\x00II")
+)
+
+// Olympus-specific fields
+var (
+	OlympusSpecialMode models.FieldName = "Olympus.SpecialMode"
+	OlympusEquipment   models.FieldName = "Olympus.Equipment"
+)
+
+var makerNoteOlympusFields = map[uint16]models.FieldName{
+	0x0200: OlympusSpecialMode, // shooting mode: normal/panorama/HDR/...
+	0x2010: OlympusEquipment,   // Equipment sub-IFD pointer: lens type and ID live here
+}
+
+// Matches reports whether make identifies an Olympus or OM System body.
+func (*olympus) Matches(make, model string) bool {
+	return make == "OLYMPUS CORPORATION" || make == "OLYMPUS IMAGING CORP." || make == "OM Digital Solutions"
+}
+
+// Parse decodes all Olympus makernote data found in x and adds it to x.
+//
+// Only the top-level IFD is decoded; the Equipment sub-IFD
+// (OlympusEquipment, tag 0x2010, which carries LensType and the lens
+// serial number) is left as its raw tag value rather than further
+// decoded, the same way Canon's CameraSettings is stored raw before
+// CanonRaw.Get selectively decodes individual fields from it.
+func (*olympus) Parse(x *exif.Exif) error {
+	if x.SkipMakerNote() {
+		return nil
+	}
+	m, err := x.Get(models.MakerNote)
+	if err != nil {
+		return nil
+	}
+
+	switch {
+	case bytes.HasPrefix(m.Val, olympusNewSignature):
+		oReader := bytes.NewReader(m.Val[12:])
+		mkNotesDir, _, err := tiff.DecodeDir(oReader, binary.LittleEndian)
+		if err != nil {
+			return err
+		}
+		x.LoadTags(mkNotesDir, makerNoteOlympusFields, false)
+	case bytes.HasPrefix(m.Val, olympusOldSignature):
+		oReader := bytes.NewReader(append(make([]byte, m.ValOffset), m.Val...))
+		oReader.Seek(int64(m.ValOffset)+8, 0)
+		mkNotesDir, _, err := tiff.DecodeDir(oReader, x.Tiff.Order)
+		if err != nil {
+			return err
+		}
+		x.LoadTags(mkNotesDir, makerNoteOlympusFields, false)
+	default:
+		return nil
+	}
+
+	return nil
+}
+
+// Encode rebuilds the Olympus maker-note IFD from whatever
+// makerNoteOlympusFields are currently set on x.
+func (*olympus) Encode(x *exif.Exif) (*tiff.Dir, error) {
+	dir := &tiff.Dir{}
+	for id, name := range makerNoteOlympusFields {
+		tag, err := x.Get(name)
+		if err != nil {
+			continue
+		}
+		tag.Id = id
+		dir.Tags = append(dir.Tags, tag)
+	}
+	if len(dir.Tags) == 0 {
+		return nil, nil
+	}
+	return dir, nil
+}
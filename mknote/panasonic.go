@@ -0,0 +1,93 @@
+package mknote
+
+import (
+	"bytes"
+
+	"github.com/rpajarola/exiftools/exif"
+	"github.com/rpajarola/exiftools/models"
+	"github.com/rpajarola/exiftools/tiff"
+)
+
+// Panasonic is a MakerNoteParser for Panasonic/Lumix makernote data.
+var Panasonic = &panasonic{}
+
+type panasonic struct{}
+
+// Matches reports whether make identifies a Panasonic body.
+func (*panasonic) Matches(make, model string) bool {
+	return make == "Panasonic"
+}
+
+// Panasonic-specific fields
+var (
+	PanasonicImageStabilization models.FieldName = "Panasonic.ImageStabilization"
+	PanasonicShutterType        models.FieldName = "Panasonic.ShutterType"
+	PanasonicShutterCount       models.FieldName = "Panasonic.ShutterCount"
+	PanasonicFilmMode           models.FieldName = "Panasonic.FilmMode"
+	PanasonicDynamicRange       models.FieldName = "Panasonic.DynamicRange"
+	PanasonicWhiteBalanceTune   models.FieldName = "Panasonic.WhiteBalanceFineTune"
+	PanasonicLensSerialNumber   models.FieldName = "Panasonic.LensSerialNumber"
+)
+
+var makerNotePanasonicFields = map[uint16]models.FieldName{
+	0x001a: PanasonicImageStabilization,
+	0x0023: PanasonicWhiteBalanceTune,
+	0x0039: PanasonicDynamicRange,
+	0x0051: PanasonicFilmMode,
+	0x0052: PanasonicLensSerialNumber,
+	0x0095: PanasonicShutterCount,
+	0x0096: PanasonicShutterType,
+}
+
+// panasonicSignature is the 12-byte identifier ("Panasonic" plus 3 NUL
+// pad bytes) at the start of every Panasonic maker note, immediately
+// followed by its IFD: a standard TIFF directory with no byte-order
+// marker of its own (it shares the parent TIFF's Order) and no embedded
+// TIFF header, whose entry offsets (for values too large to fit inline)
+// are relative to the start of the maker note itself rather than to the
+// parent TIFF, the same addressing Fujifilm's IFD uses.
+var panasonicSignature = []byte("Panasonic\x00\x00\x00")
+
+// Parse decodes all Panasonic makernote data found in x and adds it to x.
+func (*panasonic) Parse(x *exif.Exif) error {
+	if x.SkipMakerNote() {
+		return nil
+	}
+	m, err := x.Get(models.MakerNote)
+	if err != nil {
+		return nil
+	}
+	if len(m.Val) < len(panasonicSignature) || !bytes.Equal(m.Val[:len(panasonicSignature)], panasonicSignature) {
+		return nil
+	}
+
+	pReader := bytes.NewReader(m.Val)
+	if _, err := pReader.Seek(int64(len(panasonicSignature)), 0); err != nil {
+		return err
+	}
+
+	mkNotesDir, _, err := tiff.DecodeDir(pReader, x.Tiff.Order)
+	if err != nil {
+		return err
+	}
+	x.LoadTags(mkNotesDir, makerNotePanasonicFields, false)
+	return nil
+}
+
+// Encode rebuilds the Panasonic maker-note sub-IFD from whatever
+// makerNotePanasonicFields are currently set on x.
+func (*panasonic) Encode(x *exif.Exif) (*tiff.Dir, error) {
+	dir := &tiff.Dir{}
+	for id, name := range makerNotePanasonicFields {
+		tag, err := x.Get(name)
+		if err != nil {
+			continue
+		}
+		tag.Id = id
+		dir.Tags = append(dir.Tags, tag)
+	}
+	if len(dir.Tags) == 0 {
+		return nil, nil
+	}
+	return dir, nil
+}
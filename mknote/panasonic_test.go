@@ -0,0 +1,87 @@
+package mknote
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/rpajarola/exiftools/exif"
+	"github.com/rpajarola/exiftools/models"
+	"github.com/rpajarola/exiftools/tiff"
+)
+
+// buildPanasonicMakerNote constructs a synthetic Panasonic maker note: the
+// 12-byte signature directly followed by a standard IFD (no byte-order
+// marker, no sub-header), with tag entries addressed relative to the start
+// of the maker note itself, exactly as panasonic.Parse expects.
+func buildPanasonicMakerNote(order binary.ByteOrder, entries map[uint16]uint32) []byte {
+	var dir bytes.Buffer
+	binary.Write(&dir, order, uint16(len(entries)))
+
+	ids := make([]uint16, 0, len(entries))
+	for id := range entries {
+		ids = append(ids, id)
+	}
+	for _, id := range ids {
+		binary.Write(&dir, order, id)
+		binary.Write(&dir, order, uint16(tiff.DTLong))
+		binary.Write(&dir, order, uint32(1))
+		binary.Write(&dir, order, entries[id])
+	}
+	binary.Write(&dir, order, uint32(0)) // next IFD offset
+
+	return append(append([]byte(nil), panasonicSignature...), dir.Bytes()...)
+}
+
+func TestPanasonicParseRoundTrip(t *testing.T) {
+	const wantCount = 42
+	mn := buildPanasonicMakerNote(binary.BigEndian, map[uint16]uint32{
+		0x0095: wantCount, // PanasonicShutterCount
+	})
+
+	x := exif.New(&tiff.Tiff{Order: binary.BigEndian}, nil, nil)
+	x.Set(exif.Make, &tiff.Tag{Val: append([]byte("Panasonic"), 0)})
+	x.Set(exif.Model, &tiff.Tag{Val: append([]byte("DC-S5"), 0)})
+	x.Set(models.MakerNote, &tiff.Tag{Val: mn})
+
+	if err := Panasonic.Parse(x); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	tag, err := x.Get(PanasonicShutterCount)
+	if err != nil {
+		t.Fatalf("x.Get(PanasonicShutterCount): %v", err)
+	}
+	got, err := tag.Int(0)
+	if err != nil {
+		t.Fatalf("tag.Int(0): %v", err)
+	}
+	if got != wantCount {
+		t.Errorf("PanasonicShutterCount = %d, want %d", got, wantCount)
+	}
+
+	count, err := x.GetShutterCount()
+	if err != nil {
+		t.Fatalf("GetShutterCount: %v", err)
+	}
+	if count != wantCount {
+		t.Errorf("GetShutterCount() = %d, want %d", count, wantCount)
+	}
+
+	dir, err := Panasonic.Encode(x)
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	if len(dir.Tags) != 1 || dir.Tags[0].Id != 0x0095 {
+		t.Errorf("Encode produced unexpected tags: %+v", dir.Tags)
+	}
+}
+
+func TestPanasonicMatches(t *testing.T) {
+	if !Panasonic.Matches("Panasonic", "DC-S5") {
+		t.Error("Matches(\"Panasonic\", ...) = false, want true")
+	}
+	if Panasonic.Matches("SONY", "ILCE-7RM4") {
+		t.Error("Matches(\"SONY\", ...) = true, want false")
+	}
+}
@@ -0,0 +1,244 @@
+// Package plist implements just enough of Apple's binary property list
+// format (bplist00) to decode the small, self-contained plists Apple
+// embeds in a handful of MakerNote tags (AEMatrix, RunTime, and the
+// unnamed 0x004e/0x004f tags).
+package plist
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+	"unicode/utf16"
+)
+
+const magic = "bplist00"
+
+// Value is the decoded representation of a plist object: nil, bool,
+// int64, float64, time.Time, []byte, string, []Value, or map[string]Value.
+type Value interface{}
+
+// Decode parses a binary property list and returns its top-level object,
+// with all object references resolved recursively.
+func Decode(data []byte) (Value, error) {
+	if len(data) < 8 || string(data[:8]) != magic {
+		return nil, fmt.Errorf("plist: missing %q magic", magic)
+	}
+	if len(data) < 32 {
+		return nil, fmt.Errorf("plist: too short to contain a trailer")
+	}
+
+	trailer := data[len(data)-32:]
+	offsetSize := int(trailer[6])
+	objRefSize := int(trailer[7])
+	numObjects := int(binary.BigEndian.Uint64(trailer[8:16]))
+	topObject := int(binary.BigEndian.Uint64(trailer[16:24]))
+	offsetTableOffset := int(binary.BigEndian.Uint64(trailer[24:32]))
+
+	if offsetSize == 0 || objRefSize == 0 || numObjects == 0 {
+		return nil, fmt.Errorf("plist: invalid trailer")
+	}
+
+	d := &decoder{
+		data:       data,
+		offsetSize: offsetSize,
+		objRefSize: objRefSize,
+	}
+
+	offsetTable := make([]int, numObjects)
+	for i := 0; i < numObjects; i++ {
+		off := offsetTableOffset + i*offsetSize
+		v, err := d.readUint(off, offsetSize)
+		if err != nil {
+			return nil, err
+		}
+		offsetTable[i] = int(v)
+	}
+	d.offsetTable = offsetTable
+
+	if topObject < 0 || topObject >= len(offsetTable) {
+		return nil, fmt.Errorf("plist: top object index %d out of range", topObject)
+	}
+	return d.readObject(topObject)
+}
+
+type decoder struct {
+	data        []byte
+	offsetSize  int
+	objRefSize  int
+	offsetTable []int
+}
+
+func (d *decoder) readUint(off, size int) (uint64, error) {
+	if off < 0 || off+size > len(d.data) {
+		return 0, fmt.Errorf("plist: read out of range at %d (size %d)", off, size)
+	}
+	var v uint64
+	for _, b := range d.data[off : off+size] {
+		v = v<<8 | uint64(b)
+	}
+	return v, nil
+}
+
+func (d *decoder) readObjectRef(off int) (int, error) {
+	v, err := d.readUint(off, d.objRefSize)
+	return int(v), err
+}
+
+// readObject decodes the object at offsetTable[idx].
+func (d *decoder) readObject(idx int) (Value, error) {
+	if idx < 0 || idx >= len(d.offsetTable) {
+		return nil, fmt.Errorf("plist: object index %d out of range", idx)
+	}
+	off := d.offsetTable[idx]
+	if off < 0 || off >= len(d.data) {
+		return nil, fmt.Errorf("plist: object offset %d out of range", off)
+	}
+	marker := d.data[off]
+	kind := marker >> 4
+	low := marker & 0x0f
+
+	switch kind {
+	case 0x0:
+		switch low {
+		case 0x0:
+			return nil, nil // null
+		case 0x8:
+			return false, nil
+		case 0x9:
+			return true, nil
+		}
+		return nil, fmt.Errorf("plist: unknown singleton marker 0x%x", marker)
+	case 0x1: // int
+		n := 1 << low
+		v, err := d.readUint(off+1, n)
+		if err != nil {
+			return nil, err
+		}
+		// Sign-extend for the common 8-byte integer encoding.
+		if n == 8 {
+			return int64(v), nil
+		}
+		return int64(v), nil
+	case 0x2: // real
+		n := 1 << low
+		v, err := d.readUint(off+1, n)
+		if err != nil {
+			return nil, err
+		}
+		switch n {
+		case 4:
+			return float64(math.Float32frombits(uint32(v))), nil
+		case 8:
+			return math.Float64frombits(v), nil
+		}
+		return nil, fmt.Errorf("plist: unsupported real size %d", n)
+	case 0x3: // date: big-endian 8-byte float, seconds since 2001-01-01
+		v, err := d.readUint(off+1, 8)
+		if err != nil {
+			return nil, err
+		}
+		secs := math.Float64frombits(v)
+		epoch := time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)
+		return epoch.Add(time.Duration(secs * float64(time.Second))), nil
+	case 0x4: // data
+		n, body, err := d.readCountAndBody(off, low)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]byte, n)
+		copy(out, d.data[body:body+n])
+		return out, nil
+	case 0x5: // ASCII string
+		n, body, err := d.readCountAndBody(off, low)
+		if err != nil {
+			return nil, err
+		}
+		return string(d.data[body : body+n]), nil
+	case 0x6: // UTF-16BE string
+		n, body, err := d.readCountAndBody(off, low)
+		if err != nil {
+			return nil, err
+		}
+		return decodeUTF16BE(d.data[body : body+2*n]), nil
+	case 0xA: // array
+		n, body, err := d.readCountAndBody(off, low)
+		if err != nil {
+			return nil, err
+		}
+		arr := make([]Value, n)
+		for i := 0; i < n; i++ {
+			ref, err := d.readObjectRef(body + i*d.objRefSize)
+			if err != nil {
+				return nil, err
+			}
+			v, err := d.readObject(ref)
+			if err != nil {
+				return nil, err
+			}
+			arr[i] = v
+		}
+		return arr, nil
+	case 0xD: // dict
+		n, body, err := d.readCountAndBody(off, low)
+		if err != nil {
+			return nil, err
+		}
+		keyRefs := make([]int, n)
+		for i := 0; i < n; i++ {
+			ref, err := d.readObjectRef(body + i*d.objRefSize)
+			if err != nil {
+				return nil, err
+			}
+			keyRefs[i] = ref
+		}
+		valRefs := make([]int, n)
+		for i := 0; i < n; i++ {
+			ref, err := d.readObjectRef(body + (n+i)*d.objRefSize)
+			if err != nil {
+				return nil, err
+			}
+			valRefs[i] = ref
+		}
+		m := make(map[string]Value, n)
+		for i := 0; i < n; i++ {
+			k, err := d.readObject(keyRefs[i])
+			if err != nil {
+				return nil, err
+			}
+			v, err := d.readObject(valRefs[i])
+			if err != nil {
+				return nil, err
+			}
+			ks, _ := k.(string)
+			m[ks] = v
+		}
+		return m, nil
+	}
+	return nil, fmt.Errorf("plist: unsupported object marker 0x%x", marker)
+}
+
+// readCountAndBody returns the element/byte count for a length-prefixed
+// object (low nibble, or an escaped following integer if low == 0xf) and
+// the offset where its body begins.
+func (d *decoder) readCountAndBody(off int, low byte) (count, body int, err error) {
+	if low != 0xf {
+		return int(low), off + 1, nil
+	}
+	// 0x1NN marker holds the integer byte-size as a power of two.
+	intMarker := d.data[off+1]
+	n := 1 << (intMarker & 0x0f)
+	v, err := d.readUint(off+2, n)
+	if err != nil {
+		return 0, 0, err
+	}
+	return int(v), off + 2 + n, nil
+}
+
+func decodeUTF16BE(b []byte) string {
+	u16 := make([]uint16, len(b)/2)
+	for i := range u16 {
+		u16[i] = binary.BigEndian.Uint16(b[i*2:])
+	}
+	return string(utf16.Decode(u16))
+}
@@ -0,0 +1,54 @@
+package plist
+
+import (
+	"reflect"
+	"testing"
+)
+
+// minimalDict is a hand-built bplist00 encoding of {"a": 1}: two leaf
+// objects (string "a", int 1) referenced by a one-entry dict, an
+// offsetSize/objRefSize-1 offset table, and a 32-byte trailer.
+var minimalDict = []byte{
+	0x62, 0x70, 0x6c, 0x69, 0x73, 0x74, 0x30, 0x30,
+	0x51, 0x61,
+	0x10, 0x01,
+	0xd1, 0x00, 0x01,
+	0x08, 0x0a, 0x0c,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x01,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0f,
+}
+
+func TestDecodeDict(t *testing.T) {
+	v, err := Decode(minimalDict)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	dict, ok := v.(map[string]Value)
+	if !ok {
+		t.Fatalf("Decode returned %T, want map[string]Value", v)
+	}
+	want := map[string]Value{"a": int64(1)}
+	if !reflect.DeepEqual(dict, want) {
+		t.Errorf("Decode = %#v, want %#v", dict, want)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"bad magic", []byte("not-a-plist-at-all-000000000000000000000000")},
+		{"too short for trailer", []byte("bplist00")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Decode(tt.data); err == nil {
+				t.Errorf("Decode(%q): expected error, got nil", tt.data)
+			}
+		})
+	}
+}
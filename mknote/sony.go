@@ -4,17 +4,25 @@ import (
 	"bytes"
 	"encoding/binary"
 	"encoding/hex"
+	"fmt"
 	"strings"
 
 	"github.com/rpajarola/exiftools/exif"
 	"github.com/rpajarola/exiftools/tiff"
 )
 
-// Sony is an exif.Parser for sony makernote data.
+// Sony is a MakerNoteParser for sony makernote data.
 var Sony = &sony{}
 
 type sony struct{}
 
+// Matches reports whether make identifies a Sony body (or a Hasselblad
+// one, since several Hasselblad models reuse Sony's sensor and
+// maker-note format).
+func (*sony) Matches(make, model string) bool {
+	return make == "SONY" || make == "HASSELBLAD"
+}
+
 // Sony specific fields
 var (
 	SonyShutterCount          exif.FieldName = "Sony.ShutterCount"
@@ -22,6 +30,12 @@ var (
 	SonyShutterCount3         exif.FieldName = "Sony.ShutterCount3"
 	SonyInternalSerialNumber  exif.FieldName = "Sony.InternalSerialNumber"
 	SonyInternalSerialNumber2 exif.FieldName = "Sony.InternalSerialNumber2"
+	// SonyLensSpec identifies the mounted lens, the way
+	// exif.CanonLensType/NikonLensType do for their own vendors. Sony's
+	// shot count already comes from the ShutterCount fields above; its
+	// shooting mode comes from the standard Exif ExposureProgram tag
+	// rather than a Sony-specific one.
+	SonyLensSpec exif.FieldName = "Sony.LensSpec"
 
 	// only used internally
 	sony0x9050 exif.FieldName = "Sony.0x9050"
@@ -29,6 +43,7 @@ var (
 
 var makerNoteSonyFields = map[uint16]exif.FieldName{
 	0x9050: sony0x9050,
+	0xb027: SonyLensSpec,
 }
 
 var Sony0x9050Fields = map[uint16]exif.FieldName{}
@@ -185,8 +200,105 @@ var Sony0x9050BinaryTags = []SonyBinaryTag{
 	},
 }
 
+// Encode rebuilds the Sony maker-note sub-IFD from the plain
+// makerNoteSonyFields currently set on x, plus a freshly re-scrambled
+// 0x9050 block built by EncodeSony0x9050.
+func (s *sony) Encode(x *exif.Exif) (*tiff.Dir, error) {
+	dir := &tiff.Dir{}
+	for id, name := range makerNoteSonyFields {
+		if name == sony0x9050 {
+			continue // rebuilt below, not copied through verbatim
+		}
+		tag, err := x.Get(name)
+		if err != nil {
+			continue
+		}
+		tag.Id = id
+		dir.Tags = append(dir.Tags, tag)
+	}
+
+	if blob, err := s.EncodeSony0x9050(x); err == nil {
+		dir.Tags = append(dir.Tags, tiff.MakeTag(0x9050, tiff.DTUndefined, uint32(len(blob)), binary.LittleEndian, blob))
+	}
+
+	if len(dir.Tags) == 0 {
+		return nil, nil
+	}
+	return dir, nil
+}
+
+// EncodeSony0x9050 rebuilds the scrambled 0x9050 binary block, starting
+// from the descrambled copy Parse stored in x (so unknown bytes -
+// everything Sony0x9050BinaryTags doesn't describe, or that doesn't
+// checkModel for x's camera - pass through byte-identical) and patching
+// in whatever Sony0x9050Fields values the caller has since modified on
+// x, e.g. a corrected SonyShutterCount or a cleared
+// SonyInternalSerialNumber. It returns an error if x never had a 0x9050
+// block decoded (Parse wasn't run, or this MakerNote has none), since
+// there would be no base buffer to patch.
+func (*sony) EncodeSony0x9050(x *exif.Exif) ([]byte, error) {
+	model := ""
+	if m, err := x.Get(exif.Model); err == nil {
+		model, _ = m.StringVal()
+	}
+
+	base, err := x.Get(sony0x9050)
+	if err != nil {
+		return nil, fmt.Errorf("exif: sony: no 0x9050 block to rewrite: %w", err)
+	}
+	buf := append([]byte(nil), base.Val...)
+
+	for i := range Sony0x9050BinaryTags {
+		bt := Sony0x9050BinaryTags[i]
+		if !checkModel(&bt, model) {
+			continue
+		}
+		tag, err := x.Get(bt.fieldName)
+		if err != nil {
+			continue
+		}
+		if err := sonyDecodeInto(&bt, tag, buf); err != nil {
+			return nil, err
+		}
+	}
+
+	scramble0x9050(buf)
+	return buf, nil
+}
+
+// sonyDecodeInto writes tag's value (as makeBinaryTag/sonyEncode
+// produced it when Parse last ran) back into buf at bt.offset, the
+// inverse of sonyEncode.
+func sonyDecodeInto(bt *SonyBinaryTag, tag *tiff.Tag, buf []byte) error {
+	switch bt.dataType {
+	case SonyUint24:
+		if len(tag.Val) < 3 || len(buf) < bt.offset+3 {
+			return fmt.Errorf("exif: sony: %s value too short to encode", bt.fieldName)
+		}
+		copy(buf[bt.offset:bt.offset+3], tag.Val[:3])
+	case SonyUint32:
+		if len(tag.Val) < 4 || len(buf) < bt.offset+4 {
+			return fmt.Errorf("exif: sony: %s value too short to encode", bt.fieldName)
+		}
+		copy(buf[bt.offset:bt.offset+4], tag.Val[:4])
+	case SonyHexString:
+		if len(buf) < bt.offset+bt.length {
+			return fmt.Errorf("exif: sony: %s value too short to encode", bt.fieldName)
+		}
+		raw := make([]byte, bt.length)
+		if _, err := hex.Decode(raw, bytes.TrimRight(tag.Val, "\x00")); err != nil {
+			return fmt.Errorf("exif: sony: %s: %w", bt.fieldName, err)
+		}
+		copy(buf[bt.offset:bt.offset+bt.length], raw)
+	}
+	return nil
+}
+
 // Parse decodes Sony makernote data found in x and adds it to x.
 func (_ *sony) Parse(x *exif.Exif) error {
+	if x.SkipMakerNote() {
+		return nil
+	}
 	m, err := x.Get(exif.Model)
 	if err != nil {
 		return nil
@@ -197,7 +309,7 @@ func (_ *sony) Parse(x *exif.Exif) error {
 		return nil
 	}
 	mk, err := m.StringVal()
-	if mk != "SONY" && mk != "HASSELBLAD"{
+	if mk != "SONY" && mk != "HASSELBLAD" {
 		return nil
 	}
 	m, err = x.Get(exif.MakerNote)
@@ -206,15 +318,15 @@ func (_ *sony) Parse(x *exif.Exif) error {
 	}
 
 	if len(m.Val) < 13 {
-                return nil
-        }
+		return nil
+	}
 	var offset int64 = 0
-        if bytes.Compare(m.Val[:10], []byte("SONY DSC \000")) == 0 ||
-	   bytes.Compare(m.Val[:10], []byte("SONY CAM \000")) == 0 ||
-	   bytes.Compare(m.Val[:13], []byte("SONY MOBILE \000")) == 0 || // how is this possible?
-	   bytes.Compare(m.Val[:11], []byte("\000\000SONY PIC\000")) == 0 ||
-	   bytes.Compare(m.Val[:10], []byte("VHAB     \000")) == 0 {
-	   offset = 12
+	if bytes.Compare(m.Val[:10], []byte("SONY DSC \000")) == 0 ||
+		bytes.Compare(m.Val[:10], []byte("SONY CAM \000")) == 0 ||
+		bytes.Compare(m.Val[:13], []byte("SONY MOBILE \000")) == 0 || // how is this possible?
+		bytes.Compare(m.Val[:11], []byte("\000\000SONY PIC\000")) == 0 ||
+		bytes.Compare(m.Val[:10], []byte("VHAB     \000")) == 0 {
+		offset = 12
 	}
 
 	// Sony maker notes are a single IFD directory with no header.
@@ -265,6 +377,25 @@ func descramble0x9050(val []byte) {
 	}
 }
 
+// scramble0x9050 is the inverse of descramble0x9050: it re-scrambles a
+// plain 0x9050 block (e.g. one EncodeSony0x9050 just patched) back into
+// the form Sony cameras actually write, so a rewritten MakerNote still
+// matches what every other EXIF tool, including this one, expects to
+// find there.
+func scramble0x9050(val []byte) {
+	scr := make([]int, 256)
+	for i := range scr {
+		if i < 249 {
+			scr[i] = i * i * i % 249
+		} else {
+			scr[i] = i
+		}
+	}
+	for i, v := range val {
+		val[i] = byte(scr[v])
+	}
+}
+
 func makeBinaryTag(bt *SonyBinaryTag, model string, buf []byte, id int) *tiff.Tag {
 	if !checkModel(bt, model) {
 		return nil
@@ -293,19 +424,19 @@ func sonyEncode(bt *SonyBinaryTag, buf []byte) ([]byte, tiff.DataType) {
 	case SonyUint24:
 		val = make([]byte, 4)
 		dt = tiff.DTLong
-		if len(buf) >= bt.offset + 3 {
+		if len(buf) >= bt.offset+3 {
 			val = []byte{buf[bt.offset], buf[bt.offset+1], buf[bt.offset+2], 0}
 		}
 	case SonyUint32:
 		val = make([]byte, 4)
 		dt = tiff.DTLong
-		if len(buf) >= bt.offset + 4 {
+		if len(buf) >= bt.offset+4 {
 			val = []byte{buf[bt.offset], buf[bt.offset+1], buf[bt.offset+2], buf[bt.offset+3]}
 		}
 	case SonyHexString:
 		val = make([]byte, hex.EncodedLen(bt.length)+1)
 		dt = tiff.DTAscii
-		if len(buf) >= bt.offset +bt.length {
+		if len(buf) >= bt.offset+bt.length {
 			hex.Encode(val, buf[bt.offset:bt.offset+bt.length])
 		}
 	}
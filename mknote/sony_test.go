@@ -0,0 +1,90 @@
+package mknote
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/rpajarola/exiftools/exif"
+	"github.com/rpajarola/exiftools/tiff"
+)
+
+// TestScramble0x9050RoundTrip checks that scramble0x9050 is the exact
+// inverse of descramble0x9050 for every byte value, since a single
+// mismatched entry would corrupt every field below whatever offset it
+// occupies.
+func TestScramble0x9050RoundTrip(t *testing.T) {
+	val := make([]byte, 256)
+	for i := range val {
+		val[i] = byte(i)
+	}
+
+	descramble0x9050(val)
+	scramble0x9050(val)
+
+	for i, v := range val {
+		if int(v) != i {
+			t.Fatalf("scramble0x9050(descramble0x9050(x)) != x at byte %d: got %d", i, v)
+		}
+	}
+}
+
+// TestEncodeSony0x9050PreservesUnknownBytes patches a single field known
+// to checkModel for "ILCE-7RM4" and checks that every other byte of the
+// block (which Sony0x9050BinaryTags either doesn't describe, or
+// describes only for other models) round-trips unchanged.
+func TestEncodeSony0x9050PreservesUnknownBytes(t *testing.T) {
+	const model = "ILCE-7RM4"
+
+	// Large enough to hold the ILCE-7RM4 SonyShutterCount3 field at 0x019f
+	// (a SonyUint32, so it needs the block to extend through 0x01a3).
+	orig := make([]byte, 0x0200)
+	for i := range orig {
+		orig[i] = byte(i)
+	}
+	scrambled := append([]byte(nil), orig...)
+	scramble0x9050(scrambled)
+
+	x := exif.New(nil, nil, nil)
+	setStringTag(x, exif.Model, model)
+	setStringTag(x, exif.Make, "SONY")
+	x.Set(sony0x9050, &tiff.Tag{Val: append([]byte(nil), scrambled...)})
+
+	m9050, err := x.Get(sony0x9050)
+	if err != nil {
+		t.Fatalf("x.Get(sony0x9050): %v", err)
+	}
+	descramble0x9050(m9050.Val)
+
+	for i, bt := range Sony0x9050BinaryTags {
+		if tag := makeBinaryTag(&bt, model, m9050.Val, i); tag != nil {
+			x.LoadTags(&tiff.Dir{Tags: []*tiff.Tag{tag}}, map[uint16]exif.FieldName{uint16(i): bt.fieldName}, true)
+		}
+	}
+
+	// Simulate the caller correcting SonyShutterCount3 (offset 0x019f,
+	// SonyUint32, applies to ILCE-7RM4) to a known new value.
+	want := []byte{0x11, 0x22, 0x33, 0x44}
+	x.Set(SonyShutterCount3, tiff.MakeTag(0, tiff.DTLong, 1, binary.LittleEndian, want))
+
+	got, err := Sony.EncodeSony0x9050(x)
+	if err != nil {
+		t.Fatalf("EncodeSony0x9050: %v", err)
+	}
+
+	redescrambled := append([]byte(nil), got...)
+	descramble0x9050(redescrambled)
+
+	if !bytes.Equal(redescrambled[0x019f:0x019f+4], want) {
+		t.Errorf("SonyShutterCount3 not patched: got %x, want %x", redescrambled[0x019f:0x019f+4], want)
+	}
+	// Byte 0 lies outside every known tag's range and must be untouched.
+	if redescrambled[0] != orig[0] {
+		t.Errorf("unrelated byte 0 changed: got %x, want %x", redescrambled[0], orig[0])
+	}
+}
+
+func setStringTag(x *exif.Exif, name exif.FieldName, val string) {
+	b := append([]byte(val), 0)
+	x.Set(name, tiff.MakeTag(0, tiff.DTAscii, uint32(len(b)), binary.BigEndian, b))
+}
@@ -0,0 +1,140 @@
+package tiff
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"sort"
+)
+
+// SubDir associates a sub-IFD with the tag ID of the pointer tag that
+// should reference it from the parent Dir (e.g. the standard Exif, GPS,
+// and Interoperability IFD pointers). It exists so that EncodeDir can
+// splice the sub-IFD's bytes into the stream and fill in the pointer's
+// offset once the sub-IFD's position is known, matching the layout
+// Decode/DecodeDir read back.
+type SubDir struct {
+	Pointer uint16
+	Dir     *Dir
+}
+
+// EncodeDir serializes dir as a standalone tiff stream: the byte-order
+// marker, the magic 42 marker, the offset to IFD0, IFD0 itself, and
+// (recursively) any of dir's SubDirs, in the same layout Decode expects to
+// read back. It returns the number of bytes written.
+func EncodeDir(w io.Writer, dir *Dir, order binary.ByteOrder) (int, error) {
+	var buf bytes.Buffer
+
+	if order == binary.BigEndian {
+		buf.WriteString("MM")
+	} else {
+		buf.WriteString("II")
+	}
+	binary.Write(&buf, order, int16(42))
+	binary.Write(&buf, order, int32(8)) // IFD0 starts right after this 8-byte header
+
+	if err := encodeDirAt(&buf, dir, order); err != nil {
+		return 0, err
+	}
+	return w.Write(buf.Bytes())
+}
+
+// dirEncodedSize returns the number of bytes encodeDirAt writes for d,
+// without actually writing anything, so a parent Dir can compute where its
+// SubDirs will land before their pointer tags are written.
+func dirEncodedSize(d *Dir) int {
+	n := len(d.Tags) + len(d.SubDirs)
+	size := 2 + 12*n + 4 // tag count + entries + next-IFD offset
+	for _, t := range d.Tags {
+		if len(t.Val) > 4 {
+			size += paddedLen(len(t.Val))
+		}
+	}
+	for _, sd := range d.SubDirs {
+		size += dirEncodedSize(sd.Dir)
+	}
+	return size
+}
+
+func paddedLen(n int) int {
+	if n%2 == 1 {
+		return n + 1
+	}
+	return n
+}
+
+// encodeDirAt writes dir's IFD (tag count, sorted tag entries including one
+// synthesized LONG pointer tag per SubDir, a zero next-IFD offset, the
+// overflow value area, then each SubDir's own bytes) starting at buf's
+// current position, which doubles as the dir's absolute offset within the
+// stream - every ValOffset/pointer value EncodeDir writes is relative to
+// the start of the 8-byte tiff header, exactly as DecodeDir expects.
+func encodeDirAt(buf *bytes.Buffer, dir *Dir, order binary.ByteOrder) error {
+	entries := make([]*Tag, 0, len(dir.Tags)+len(dir.SubDirs))
+	entries = append(entries, dir.Tags...)
+
+	subDirOffsets := make([]uint32, len(dir.SubDirs))
+	pointerTags := make([]*Tag, len(dir.SubDirs))
+
+	for i, sd := range dir.SubDirs {
+		pt := &Tag{Id: sd.Pointer, Type: DTLong, Count: 1}
+		pointerTags[i] = pt
+		entries = append(entries, pt)
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Id < entries[j].Id })
+
+	n := len(entries)
+	valueAreaPos := buf.Len() + 2 + 12*n + 4
+
+	overflowOffsets := make(map[*Tag]uint32, n)
+	pos := uint32(valueAreaPos)
+	for _, t := range entries {
+		if len(t.Val) > 4 {
+			overflowOffsets[t] = pos
+			pos += uint32(paddedLen(len(t.Val)))
+		}
+	}
+
+	subDirsStartPos := pos
+	for i, sd := range dir.SubDirs {
+		subDirOffsets[i] = subDirsStartPos
+		subDirsStartPos += uint32(dirEncodedSize(sd.Dir))
+	}
+	for i, pt := range pointerTags {
+		offBytes := make([]byte, 4)
+		order.PutUint32(offBytes, subDirOffsets[i])
+		pt.Val = offBytes
+	}
+
+	binary.Write(buf, order, int16(n))
+	for _, t := range entries {
+		binary.Write(buf, order, t.Id)
+		binary.Write(buf, order, uint16(t.Type))
+		binary.Write(buf, order, t.Count)
+		valOrOffset := make([]byte, 4)
+		if off, ok := overflowOffsets[t]; ok {
+			order.PutUint32(valOrOffset, off)
+		} else {
+			copy(valOrOffset, t.Val)
+		}
+		buf.Write(valOrOffset)
+	}
+	binary.Write(buf, order, int32(0)) // no next IFD
+
+	for _, t := range entries {
+		if _, ok := overflowOffsets[t]; ok {
+			buf.Write(t.Val)
+			if len(t.Val)%2 == 1 {
+				buf.WriteByte(0)
+			}
+		}
+	}
+
+	for _, sd := range dir.SubDirs {
+		if err := encodeDirAt(buf, sd.Dir, order); err != nil {
+			return err
+		}
+	}
+	return nil
+}